@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+data "openai_admin_api_keys" "all" {}
+
+output "unused_admin_keys" {
+  value = [for k in data.openai_admin_api_keys.all.admin_api_keys : k.name if k.last_used_at == null]
+}
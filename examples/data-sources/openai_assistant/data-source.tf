@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+data "openai_assistant" "by_id" {
+  id = "asst_abc123"
+}
+
+data "openai_assistant" "by_name" {
+  name = "Support Assistant"
+}
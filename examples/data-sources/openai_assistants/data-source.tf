@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+data "openai_assistants" "example" {
+  order = "asc"
+  model = "gpt-4o"
+
+  metadata = {
+    team = "platform"
+  }
+}
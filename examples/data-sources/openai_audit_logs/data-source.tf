@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+resource "openai_project" "analytics" {
+  name = "analytics"
+}
+
+resource "openai_project_service_account" "pipeline" {
+  project_id = openai_project.analytics.id
+  name       = "pipeline"
+}
+
+check "no_out_of_band_service_accounts" {
+  data "openai_audit_logs" "service_accounts" {
+    event_types = ["service_account.created"]
+    project_ids = [openai_project.analytics.id]
+  }
+
+  assert {
+    condition = alltrue([
+      for log in data.openai_audit_logs.service_accounts.audit_logs :
+      log.resource_id == openai_project_service_account.pipeline.id
+    ])
+    error_message = "Service accounts were created outside of Terraform in project ${openai_project.analytics.name}."
+  }
+}
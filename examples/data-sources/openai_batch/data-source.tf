@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+data "openai_batch" "example" {
+  id = "batch_abc123"
+}
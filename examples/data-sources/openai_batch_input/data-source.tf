@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+locals {
+  questions = {
+    "question-1" = "What is the capital of France?"
+    "question-2" = "What is the capital of Japan?"
+  }
+}
+
+data "openai_batch_input" "example" {
+  requests = [
+    for id, question in local.questions : {
+      custom_id = id
+      url       = "/v1/chat/completions"
+      body = {
+        model    = "gpt-4o-mini"
+        messages = [{ role = "user", content = question }]
+      }
+    }
+  ]
+}
+
+resource "openai_file" "batch_input" {
+  content  = data.openai_batch_input.example.jsonl
+  filename = "questions.jsonl"
+  purpose  = "batch"
+}
+
+resource "openai_batch" "example" {
+  input_file_id = openai_file.batch_input.id
+  endpoint      = data.openai_batch_input.example.endpoint
+}
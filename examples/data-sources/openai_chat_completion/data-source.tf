@@ -0,0 +1,52 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+variable "bucket_name" {
+  type    = string
+  default = "customer-invoices-archive"
+}
+
+# Classify a resource to derive its tags. A seed and a temperature of 0 keep
+# the output as stable as possible between plans.
+data "openai_chat_completion" "classification" {
+  model       = "gpt-4o-mini"
+  seed        = 42
+  temperature = 0
+  messages = [
+    {
+      role    = "system"
+      content = "Classify the storage bucket by the sensitivity of the data it is likely to hold."
+    },
+    {
+      role    = "user"
+      content = var.bucket_name
+    }
+  ]
+  response_format = {
+    type = "json_schema"
+    json_schema = {
+      name   = "classification"
+      strict = true
+      schema = jsonencode({
+        type = "object"
+        properties = {
+          sensitivity = { type = "string", enum = ["public", "internal", "confidential"] }
+          reason      = { type = "string" }
+        }
+        required             = ["sensitivity", "reason"]
+        additionalProperties = false
+      })
+    }
+  }
+}
+
+output "sensitivity" {
+  value = data.openai_chat_completion.classification.parsed.sensitivity
+}
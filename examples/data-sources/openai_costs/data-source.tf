@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+variable "monthly_budgets" {
+  type = map(number)
+  default = {
+    analytics = 100
+    support   = 250
+  }
+}
+
+resource "openai_project" "budgeted" {
+  for_each = var.monthly_budgets
+  name     = each.key
+}
+
+data "openai_costs" "budgeted" {
+  for_each    = openai_project.budgeted
+  start_time  = "2025-01-01T00:00:00Z"
+  end_time    = "2025-02-01T00:00:00Z"
+  project_ids = [each.value.id]
+}
+
+output "budget_remaining" {
+  value = {
+    for name, costs in data.openai_costs.budgeted : name => var.monthly_budgets[name] - costs.total_amount
+  }
+}
+
+check "budgets" {
+  assert {
+    condition     = alltrue([for name, costs in data.openai_costs.budgeted : costs.total_amount <= var.monthly_budgets[name]])
+    error_message = "A project has exceeded its monthly budget."
+  }
+}
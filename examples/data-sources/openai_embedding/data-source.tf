@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+locals {
+  intents = ["billing", "technical support", "account access", "sales"]
+}
+
+data "openai_embedding" "intents" {
+  model      = "text-embedding-3-small"
+  input      = local.intents
+  dimensions = 256
+}
+
+# Ship the intent vectors to the router as JSON.
+output "intent_vectors" {
+  value = jsonencode(zipmap(local.intents, data.openai_embedding.intents.embeddings))
+}
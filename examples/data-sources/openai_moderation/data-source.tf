@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+locals {
+  instructions = file("${path.module}/instructions.md")
+}
+
+data "openai_moderation" "instructions" {
+  input = [local.instructions]
+}
+
+resource "openai_assistant" "support" {
+  name         = "support"
+  model        = "gpt-4o-mini"
+  instructions = local.instructions
+
+  lifecycle {
+    precondition {
+      condition     = !data.openai_moderation.instructions.flagged
+      error_message = "The assistant instructions were flagged for: ${join(", ", data.openai_moderation.instructions.flagged_categories)}."
+    }
+  }
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+resource "openai_project" "analytics" {
+  name = "analytics"
+}
+
+data "openai_usage_completions" "analytics" {
+  start_time   = "2025-01-01T00:00:00Z"
+  end_time     = "2025-02-01T00:00:00Z"
+  bucket_width = "1d"
+  project_ids  = [openai_project.analytics.id]
+  group_by     = ["model"]
+}
+
+output "analytics_tokens" {
+  value = data.openai_usage_completions.analytics.totals.input_tokens + data.openai_usage_completions.analytics.totals.output_tokens
+}
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+provider "openai" {}
+
+resource "openai_project" "analytics" {
+  name = "analytics"
+}
+
+ephemeral "openai_project_service_account_api_key" "pipeline" {
+  project_id = openai_project.analytics.id
+  name       = "pipeline-key-rotation"
+}
+
+resource "aws_secretsmanager_secret" "openai" {
+  name = "analytics/openai-api-key"
+}
+
+# The key is written to the secret without being stored in state. The key is
+# revoked when the Terraform run completes, so it suits jobs started during the run.
+resource "aws_secretsmanager_secret_version" "openai" {
+  secret_id                = aws_secretsmanager_secret.openai.id
+  secret_string_wo         = ephemeral.openai_project_service_account_api_key.pipeline.value
+  secret_string_wo_version = 1
+}
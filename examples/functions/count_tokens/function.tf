@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+locals {
+  instructions = file("${path.module}/instructions.md")
+}
+
+output "instructions_tokens" {
+  value = provider::openai::count_tokens("gpt-4o", local.instructions)
+}
+
+resource "openai_assistant" "support" {
+  name         = "support"
+  model        = "gpt-4o"
+  instructions = local.instructions
+
+  lifecycle {
+    precondition {
+      condition     = provider::openai::count_tokens("gpt-4o", local.instructions) <= 4000
+      error_message = "The instructions must be at most 4000 tokens."
+    }
+  }
+}
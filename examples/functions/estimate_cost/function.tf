@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+variable "daily_requests" {
+  type    = number
+  default = 10000
+}
+
+locals {
+  prompt = "Summarise the following support ticket in one sentence."
+}
+
+output "estimated_daily_cost" {
+  value = var.daily_requests * provider::openai::estimate_cost(
+    "gpt-4o-mini",
+    provider::openai::count_tokens("gpt-4o-mini", local.prompt) + 500,
+    100,
+  )
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+variable "response_schema" {
+  type    = string
+  default = jsonencode({
+    type = "object"
+    properties = {
+      sentiment = { type = "string", enum = ["positive", "neutral", "negative"] }
+    }
+    required             = ["sentiment"]
+    additionalProperties = false
+  })
+
+  validation {
+    condition     = provider::openai::validate_json_schema(var.response_schema)
+    error_message = "The response schema must be a valid JSON schema."
+  }
+}
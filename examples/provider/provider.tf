@@ -7,3 +7,22 @@ terraform {
 }
 
 provider "openai" {}
+
+# Read the keys from files, e.g. mounted secrets.
+provider "openai" {
+  alias          = "files"
+  api_key_file   = "/run/secrets/openai_api_key"
+  admin_key_file = "/run/secrets/openai_admin_key"
+}
+
+# Read the API key from a password manager.
+provider "openai" {
+  alias           = "command"
+  api_key_command = ["op", "read", "op://ci/openai/api-key"]
+}
+
+# Read the keys from the [prod] profile of ~/.openai/credentials.
+provider "openai" {
+  alias   = "prod"
+  profile = "prod"
+}
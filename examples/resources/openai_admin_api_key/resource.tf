@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+    time = {
+      source = "hashicorp/time"
+    }
+  }
+}
+
+provider "openai" {}
+
+resource "time_rotating" "break_glass" {
+  rotation_days = 30
+}
+
+resource "openai_admin_api_key" "break_glass" {
+  name = "break-glass"
+
+  lifecycle {
+    replace_triggered_by = [time_rotating.break_glass]
+  }
+}
+
+output "break_glass_admin_key" {
+  value     = openai_admin_api_key.break_glass.value
+  sensitive = true
+}
@@ -35,7 +35,16 @@ resource "openai_assistant" "test" {
   model        = "gpt-3.5-turbo"
   instructions = "You are a personal math tutor. When asked a question, write and run Python code to answer the question."
   tools = [
-    { type = "file_search" }
+    {
+      type = "file_search"
+      file_search = {
+        max_num_results = 10
+        ranking_options = {
+          ranker          = "default_2024_08_21"
+          score_threshold = 0.5
+        }
+      }
+    }
   ]
   tool_resources = {
     file_search = {
@@ -44,4 +53,22 @@ resource "openai_assistant" "test" {
       ]
     }
   }
-}
\ No newline at end of file
+}
+
+resource "openai_assistant" "prompted" {
+  name              = "prompted_assistant"
+  model             = "gpt-4o-mini"
+  instructions_file = "./prompts/tutor.md"
+  template_vars = {
+    audience = "students"
+  }
+  tools = [
+    {
+      type = "function"
+      function = {
+        name            = "get_weather"
+        parameters_file = "./schemas/get_weather.json"
+      }
+    }
+  ]
+}
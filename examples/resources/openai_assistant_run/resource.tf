@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+resource "openai_assistant" "classifier" {
+  name         = "ticket-classifier"
+  model        = "gpt-4o-mini"
+  instructions = "Classify the support ticket. Reply with JSON containing a category and a priority from 1 to 3."
+  response_format = {
+    type = "json_object"
+  }
+}
+
+# Smoke test the assistant on every apply that changes it.
+resource "openai_assistant_run" "smoke_test" {
+  assistant_id = openai_assistant.classifier.id
+  prompt       = "My invoice was charged twice this month."
+
+  expect_json_schema = jsonencode({
+    type     = "object"
+    required = ["category", "priority"]
+    properties = {
+      category = { type = "string" }
+      priority = { type = "integer", minimum = 1, maximum = 3 }
+    }
+  })
+
+  triggers = {
+    release = "2024-10"
+  }
+
+  # Run the smoke test again whenever the assistant changes.
+  lifecycle {
+    replace_triggered_by = [openai_assistant.classifier]
+  }
+}
+
+output "smoke_test_output" {
+  value = openai_assistant_run.smoke_test.output
+}
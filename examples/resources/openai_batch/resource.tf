@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+resource "openai_file" "batch_input" {
+  filepath = "./batch_input.jsonl"
+  purpose  = "batch"
+}
+
+resource "openai_batch" "example" {
+  input_file_id = openai_file.batch_input.id
+  endpoint      = "/v1/embeddings"
+  wait          = true
+
+  metadata = {
+    job = "nightly-embeddings"
+  }
+}
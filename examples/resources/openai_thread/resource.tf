@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    openai = {
+      source = "skyscrapr/openai"
+    }
+  }
+}
+
+provider "openai" {}
+
+resource "openai_file" "data" {
+  filepath = "./data.csv"
+  purpose  = "assistants"
+}
+
+resource "openai_thread" "demo" {
+  messages = [
+    {
+      role    = "user"
+      content = "Summarise the attached sales data."
+      attachments = [
+        {
+          file_id = openai_file.data.id
+          tools   = ["code_interpreter"]
+        }
+      ]
+    }
+  ]
+  metadata = {
+    environment = "demo"
+  }
+}
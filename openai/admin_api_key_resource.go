@@ -0,0 +1,231 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AdminApiKeyResource{}
+var _ resource.ResourceWithImportState = &AdminApiKeyResource{}
+
+func NewAdminApiKeyResource() resource.Resource {
+	return &AdminApiKeyResource{OpenAIResource: &OpenAIResource{}}
+}
+
+// AdminApiKeyResource defines the resource implementation.
+type AdminApiKeyResource struct {
+	*OpenAIResource
+}
+
+// AdminApiKeyResourceModel describes the resource data model.
+type AdminApiKeyResourceModel struct {
+	Id            types.String           `tfsdk:"id"`
+	Object        types.String           `tfsdk:"object"`
+	Name          types.String           `tfsdk:"name"`
+	Value         types.String           `tfsdk:"value"`
+	RedactedValue types.String           `tfsdk:"redacted_value"`
+	CreatedAt     types.Int64            `tfsdk:"created_at"`
+	LastUsedAt    types.Int64            `tfsdk:"last_used_at"`
+	Owner         *AdminApiKeyOwnerModel `tfsdk:"owner"`
+}
+
+// NewAdminApiKeyResourceModel flattens an admin API key. The value is only
+// returned when the key is created, so value holds the one from state otherwise.
+func NewAdminApiKeyResourceModel(key *AdminApiKey, value types.String) AdminApiKeyResourceModel {
+	m := NewAdminApiKeyModel(key)
+	if key.Value != nil {
+		value = types.StringValue(*key.Value)
+	}
+	return AdminApiKeyResourceModel{
+		Id:            m.Id,
+		Object:        m.Object,
+		Name:          m.Name,
+		Value:         value,
+		RedactedValue: m.RedactedValue,
+		CreatedAt:     m.CreatedAt,
+		LastUsedAt:    m.LastUsedAt,
+		Owner:         m.Owner,
+	}
+}
+
+func (r *AdminApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_api_key"
+}
+
+func (r *AdminApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Represents an organization admin API key. Requires an admin key. " +
+			"The value of the key is only returned when it is created, so it is null for imported keys. " +
+			"Use `replace_triggered_by` with a `time_rotating` resource to rotate the key on a schedule.",
+
+		Attributes: map[string]schema.Attribute{
+			"id":     computedString("The identifier, which can be referenced in API endpoints."),
+			"object": computedString("The object type, which is always organization.admin_api_key."),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the API key. Changing the name creates a new key.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the API key. Only available in the state of the resource that created the key.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redacted_value": computedString("The redacted value of the API key."),
+			"created_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) of when the API key was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) of when the API key was last used.",
+				Computed:            true,
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "The user that owns the API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The identifier of the user.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the user.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the owner, which is always user.",
+						Computed:            true,
+					},
+					"role": schema.StringAttribute{
+						MarkdownDescription: "The role of the user in the organization: owner or reader.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AdminApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AdminApiKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating Admin API Key...")
+	key, err := r.client.WithContext(ctx).AdminApiKeys().CreateAdminApiKey(&CreateAdminApiKeyRequest{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create admin api key", err))
+		return
+	}
+	tflog.Info(ctx, "Admin API Key created successfully")
+
+	data := NewAdminApiKeyResourceModel(key, types.StringNull())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdminApiKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading Admin API Key with id: %s", data.Id.ValueString()))
+	key, err := r.client.WithContext(ctx).AdminApiKeys().RetrieveAdminApiKey(data.Id.ValueString())
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Admin API Key does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve admin api key", err))
+		return
+	}
+
+	data = NewAdminApiKeyResourceModel(key, data.Value)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Admin API Key is not supported")
+}
+
+func (r *AdminApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AdminApiKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting Admin API Key: %s", data.Id.ValueString()))
+	deleted, err := r.client.WithContext(ctx).AdminApiKeys().DeleteAdminApiKey(data.Id.ValueString())
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Admin API Key does not exist")
+			return
+		}
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to delete admin api key", err))
+		return
+	}
+	if !deleted {
+		tflog.Trace(ctx, "Admin API Key not deleted")
+	}
+	tflog.Trace(ctx, "Admin API Key deleted successfully")
+}
+
+func (r *AdminApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
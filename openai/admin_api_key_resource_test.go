@@ -0,0 +1,127 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAdminApiKeyResource(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAdminApiKeyResourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_admin_api_key.test", "id"),
+					resource.TestCheckResourceAttr("openai_admin_api_key.test", "name", rName),
+					resource.TestCheckResourceAttrSet("openai_admin_api_key.test", "value"),
+					resource.TestCheckResourceAttrSet("openai_admin_api_key.test", "redacted_value"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "openai_admin_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value", "last_used_at"},
+			},
+			// Replace testing
+			{
+				Config: testAccAdminApiKeyResourceConfig(rName + "-rotated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openai_admin_api_key.test", "name", rName+"-rotated"),
+					resource.TestCheckResourceAttrSet("openai_admin_api_key.test", "value"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAdminApiKeyResourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "openai_admin_api_key" "test" {
+	name = %[1]q
+}
+`, rName)
+}
+
+const testAdminApiKeyResponse = `{
+	"object": "organization.admin_api_key",
+	"id": "key_abc",
+	"name": "break-glass",
+	"redacted_value": "sk-admin...xyz",
+	%s
+	"created_at": 1711471533,
+	"last_used_at": null,
+	"owner": {"type": "user", "object": "organization.user", "id": "user_123", "name": "Ops", "created_at": 1711471533, "role": "owner"}
+}`
+
+func TestAdminApiKeyResource_CreateRead(t *testing.T) {
+	ctx := context.Background()
+	client, recorder := newRecordingTestClient(t, fmt.Sprintf(testAdminApiKeyResponse, `"value": "sk-admin-secret",`))
+	r := &AdminApiKeyResource{OpenAIResource: &OpenAIResource{client: client}}
+	s := resourceSchema(ctx, r)
+
+	plan := AdminApiKeyResourceModel{
+		Id:            types.StringUnknown(),
+		Object:        types.StringUnknown(),
+		Name:          types.StringValue("break-glass"),
+		Value:         types.StringUnknown(),
+		RedactedValue: types.StringUnknown(),
+		CreatedAt:     types.Int64Unknown(),
+		LastUsedAt:    types.Int64Unknown(),
+	}
+	createResp := fwresource.CreateResponse{State: newTestState(t, ctx, s, nil)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, ctx, s, &plan)}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	req := recorder.last(t)
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "/v1/organization/admin_api_keys", req.Path)
+	assert.Equal(t, map[string]interface{}{"name": "break-glass"}, req.Body)
+
+	var state AdminApiKeyResourceModel
+	assert.False(t, createResp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "sk-admin-secret", state.Value.ValueString())
+	assert.Equal(t, "owner", state.Owner.Role.ValueString())
+	assert.True(t, state.LastUsedAt.IsNull())
+
+	// The value is not returned when the key is retrieved, so it is kept from state.
+	client, _ = newRecordingTestClient(t, fmt.Sprintf(testAdminApiKeyResponse, ""))
+	r.client = client
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.False(t, readResp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "sk-admin-secret", state.Value.ValueString())
+}
+
+func TestAdminApiKeyResource_NotFound(t *testing.T) {
+	ctx := context.Background()
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"message": "No such admin api key", "type": "invalid_request_error", "code": null}}`))
+	})
+	r := &AdminApiKeyResource{OpenAIResource: &OpenAIResource{client: client}}
+	s := resourceSchema(ctx, r)
+
+	state := NewAdminApiKeyResourceModel(&AdminApiKey{Id: "key_abc", Name: "break-glass"}, types.StringValue("sk-admin-secret"))
+	readResp := fwresource.ReadResponse{State: newTestState(t, ctx, s, &state)}
+	r.Read(ctx, fwresource.ReadRequest{State: newTestState(t, ctx, s, &state)}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+
+	deleteResp := fwresource.DeleteResponse{State: newTestState(t, ctx, s, &state)}
+	r.Delete(ctx, fwresource.DeleteRequest{State: newTestState(t, ctx, s, &state)}, &deleteResp)
+	assert.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+}
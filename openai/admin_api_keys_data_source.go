@@ -0,0 +1,181 @@
+package openai
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AdminApiKeysDataSource{}
+
+func NewAdminApiKeysDataSource() datasource.DataSource {
+	return &AdminApiKeysDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// AdminApiKeysDataSource defines the data source implementation.
+type AdminApiKeysDataSource struct {
+	*OpenAIDatasource
+}
+
+// AdminApiKeysModel describes the data source data model.
+type AdminApiKeysModel struct {
+	Id           types.String       `tfsdk:"id"`
+	AdminApiKeys []AdminApiKeyModel `tfsdk:"admin_api_keys"`
+}
+
+type AdminApiKeyModel struct {
+	Id            types.String           `tfsdk:"id"`
+	Object        types.String           `tfsdk:"object"`
+	Name          types.String           `tfsdk:"name"`
+	RedactedValue types.String           `tfsdk:"redacted_value"`
+	CreatedAt     types.Int64            `tfsdk:"created_at"`
+	LastUsedAt    types.Int64            `tfsdk:"last_used_at"`
+	Owner         *AdminApiKeyOwnerModel `tfsdk:"owner"`
+}
+
+type AdminApiKeyOwnerModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	Role types.String `tfsdk:"role"`
+}
+
+func NewAdminApiKeyModel(key *AdminApiKey) AdminApiKeyModel {
+	model := AdminApiKeyModel{
+		Id:            types.StringValue(key.Id),
+		Object:        types.StringValue(key.Object),
+		Name:          types.StringValue(key.Name),
+		RedactedValue: types.StringValue(key.RedactedValue),
+		CreatedAt:     types.Int64Value(key.CreatedAt),
+		LastUsedAt:    types.Int64PointerValue(key.LastUsedAt),
+	}
+	if key.Owner != nil {
+		model.Owner = &AdminApiKeyOwnerModel{
+			Id:   types.StringValue(key.Owner.Id),
+			Name: types.StringValue(key.Owner.Name),
+			Type: types.StringValue(key.Owner.Type),
+			Role: types.StringValue(key.Owner.Role),
+		}
+	}
+	return model
+}
+
+func (d *AdminApiKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_api_keys"
+}
+
+func (d *AdminApiKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Admin API keys data source. Lists the admin keys of the organization. Requires an admin key.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Admin API keys identifier",
+				Computed:            true,
+			},
+			"admin_api_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "Admin API keys",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The identifier, which can be referenced in API endpoints.",
+							Computed:            true,
+						},
+						"object": schema.StringAttribute{
+							MarkdownDescription: "The object type, which is always organization.admin_api_key.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the API key.",
+							Computed:            true,
+						},
+						"redacted_value": schema.StringAttribute{
+							MarkdownDescription: "The redacted value of the API key.",
+							Computed:            true,
+						},
+						"created_at": schema.Int64Attribute{
+							MarkdownDescription: "The Unix timestamp (in seconds) of when the API key was created.",
+							Computed:            true,
+						},
+						"last_used_at": schema.Int64Attribute{
+							MarkdownDescription: "The Unix timestamp (in seconds) of when the API key was last used.",
+							Computed:            true,
+						},
+						"owner": schema.SingleNestedAttribute{
+							MarkdownDescription: "The user that owns the API key.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									MarkdownDescription: "The identifier of the user.",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the user.",
+									Computed:            true,
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the owner, which is always user.",
+									Computed:            true,
+								},
+								"role": schema.StringAttribute{
+									MarkdownDescription: "The role of the user in the organization: owner or reader.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AdminApiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AdminApiKeysModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := listAllAdminApiKeys(d.client)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Admin API Keys", err))
+		return
+	}
+
+	data.AdminApiKeys = []AdminApiKeyModel{}
+	for i := range keys {
+		data.AdminApiKeys = append(data.AdminApiKeys, NewAdminApiKeyModel(&keys[i]))
+	}
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func listAllAdminApiKeys(client *OpenAIClient) ([]AdminApiKey, error) {
+	var keys []AdminApiKey
+	req := &ListAdminApiKeysRequest{}
+	for {
+		page, err := client.AdminApiKeys().ListAdminApiKeys(req)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, page.Data...)
+		if !page.HasMore || page.LastID == "" {
+			break
+		}
+		req.After = &page.LastID
+	}
+	return keys, nil
+}
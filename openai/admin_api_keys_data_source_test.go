@@ -0,0 +1,66 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAdminApiKeysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAdminApiKeysDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openai_admin_api_keys.test", "id"),
+					resource.TestCheckResourceAttrSet("data.openai_admin_api_keys.test", "admin_api_keys.0.redacted_value"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAdminApiKeysDataSourceConfig = `
+data "openai_admin_api_keys" "test" {
+}
+`
+
+func TestAdminApiKeysDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/organization/admin_api_keys", r.URL.Path)
+		switch r.URL.Query().Get("after") {
+		case "":
+			w.Write([]byte(`{"object": "list", "data": [{"object": "organization.admin_api_key", "id": "key_1", "name": "ci", "redacted_value": "sk-admin...1", "created_at": 1711471533, "last_used_at": 1711471534}], "first_id": "key_1", "last_id": "key_1", "has_more": true}`))
+		case "key_1":
+			w.Write([]byte(`{"object": "list", "data": [{"object": "organization.admin_api_key", "id": "key_2", "name": "break-glass", "redacted_value": "sk-admin...2", "created_at": 1711471535, "owner": {"type": "user", "id": "user_123", "name": "Ops", "role": "owner"}}], "first_id": "key_2", "last_id": "key_2", "has_more": false}`))
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("after"))
+		}
+	})
+	d := &AdminApiKeysDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	config := AdminApiKeysModel{Id: types.StringNull()}
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state AdminApiKeysModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	if assert.Len(t, state.AdminApiKeys, 2) {
+		assert.Equal(t, int64(1711471534), state.AdminApiKeys[0].LastUsedAt.ValueInt64())
+		assert.Nil(t, state.AdminApiKeys[0].Owner)
+		assert.Equal(t, "break-glass", state.AdminApiKeys[1].Name.ValueString())
+		assert.True(t, state.AdminApiKeys[1].LastUsedAt.IsNull())
+		assert.Equal(t, "user_123", state.AdminApiKeys[1].Owner.Id.ValueString())
+	}
+}
@@ -0,0 +1,291 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AssistantDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &AssistantDataSource{}
+
+func NewAssistantDataSource() datasource.DataSource {
+	return &AssistantDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// AssistantDataSource defines the data source implementation.
+type AssistantDataSource struct {
+	*OpenAIDatasource
+}
+
+func (d *AssistantDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assistant"
+}
+
+func (d *AssistantDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Assistant data source. Looks up an assistant by `id` or by its exact `name`.",
+
+		Attributes: openAIAssistantAttributes(),
+	}
+}
+
+func (d *AssistantDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *AssistantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OpenAIAssistantResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assistant *Assistant
+	if !data.Id.IsNull() {
+		var err error
+		assistant, err = d.client.WithContext(ctx).Assistants().RetrieveAssistant(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Assistant", err))
+			return
+		}
+	} else {
+		assistants, err := listAllAssistants(d.client, nil)
+		if err != nil {
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Assistants", err))
+			return
+		}
+		var matches []Assistant
+		for _, a := range assistants {
+			if a.Name != nil && *a.Name == data.Name.ValueString() {
+				matches = append(matches, a)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Assistant Not Found", fmt.Sprintf("No assistant named %q was found.", data.Name.ValueString()))
+			return
+		case 1:
+			assistant = &matches[0]
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Multiple Assistants Found", fmt.Sprintf("Found %d assistants named %q. Use id to select one of them.", len(matches), data.Name.ValueString()))
+			return
+		}
+	}
+
+	data, diags = NewOpenAIAssistantResourceModel(ctx, assistant)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllAssistants pages through every assistant in the given order.
+func listAllAssistants(client *OpenAIClient, order *string) ([]Assistant, error) {
+	var assistants []Assistant
+	req := &ListAssistantsRequest{Order: order}
+	for {
+		page, err := client.Assistants().ListAssistants(req)
+		if err != nil {
+			return nil, err
+		}
+		assistants = append(assistants, page.Data...)
+		if !page.HasMore || page.LastID == "" {
+			break
+		}
+		req.After = &page.LastID
+	}
+	return assistants, nil
+}
+
+func openAIAssistantAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The identifier, which can be referenced in API endpoints.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"object": schema.StringAttribute{
+			MarkdownDescription: "The object type, which is always assistant.",
+			Computed:            true,
+		},
+		"created_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the assistant was created.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the assistant.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the assistant.",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			MarkdownDescription: "ID of the model used by the assistant.",
+			Computed:            true,
+		},
+		"instructions": schema.StringAttribute{
+			MarkdownDescription: "The system instructions that the assistant uses.",
+			Computed:            true,
+		},
+		"tools": schema.ListNestedAttribute{
+			MarkdownDescription: "A list of tool enabled on the assistant.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Tools can be of types code_interpreter, file_search, or function.",
+						Computed:            true,
+					},
+					"function": schema.SingleNestedAttribute{
+						MarkdownDescription: "Function definition for tools of type function.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"description": schema.StringAttribute{
+								MarkdownDescription: "A description of what the function does, used by the model to choose when and how to call the function.",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the function to be called.",
+								Computed:            true,
+							},
+							"parameters": schema.StringAttribute{
+								CustomType:          JSONStringType{},
+								MarkdownDescription: "The parameters the functions accepts, described as a JSON Schema object.",
+								Computed:            true,
+							},
+							"parameters_file": schema.StringAttribute{
+								MarkdownDescription: "Only used by the openai_assistant resource. Always null.",
+								Computed:            true,
+							},
+						},
+					},
+					"file_search": schema.SingleNestedAttribute{
+						MarkdownDescription: "Overrides for tools of type file_search.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"max_num_results": schema.Int64Attribute{
+								MarkdownDescription: "The maximum number of results the file search tool should output.",
+								Computed:            true,
+							},
+							"ranking_options": schema.SingleNestedAttribute{
+								MarkdownDescription: "The ranking options for the file search.",
+								Computed:            true,
+								Attributes: map[string]schema.Attribute{
+									"ranker": schema.StringAttribute{
+										MarkdownDescription: "The ranker used for the file search.",
+										Computed:            true,
+									},
+									"score_threshold": schema.Float64Attribute{
+										MarkdownDescription: "The score threshold for the file search.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"tool_resources": schema.SingleNestedAttribute{
+			MarkdownDescription: "A set of resources that are used by the assistant's tools.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"code_interpreter": schema.SingleNestedAttribute{
+					MarkdownDescription: "Resources for the code_interpreter tool.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"file_ids": schema.ListAttribute{
+							MarkdownDescription: "A list of file IDs made available to the code_interpreter tool.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+				"file_search": schema.SingleNestedAttribute{
+					MarkdownDescription: "Resources for the file_search tool.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"vector_store_ids": schema.ListAttribute{
+							MarkdownDescription: "The vector stores attached to this assistant.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		"metadata": schema.MapAttribute{
+			MarkdownDescription: "Set of 16 key-value pairs attached to the assistant.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"temperature": schema.Float64Attribute{
+			MarkdownDescription: "The sampling temperature used by the assistant.",
+			Computed:            true,
+		},
+		"top_p": schema.Float64Attribute{
+			MarkdownDescription: "The nucleus sampling probability mass used by the assistant.",
+			Computed:            true,
+		},
+		"reasoning_effort": schema.StringAttribute{
+			MarkdownDescription: "The reasoning effort used by reasoning models.",
+			Computed:            true,
+		},
+		"response_format": schema.SingleNestedAttribute{
+			MarkdownDescription: "Specifies the format that the model must output.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of response format: text, json_object or json_schema.",
+					Computed:            true,
+				},
+				"json_schema": schema.SingleNestedAttribute{
+					MarkdownDescription: "Structured Outputs configuration for the json_schema response format.",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description of what the response format is for, used by the model to determine how to respond in the format.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the response format.",
+							Computed:            true,
+						},
+						"schema": schema.StringAttribute{
+							CustomType:          JSONStringType{},
+							MarkdownDescription: "The schema for the response format, described as a JSON Schema object.",
+							Computed:            true,
+						},
+						"strict": schema.BoolAttribute{
+							MarkdownDescription: "Whether strict schema adherence is enabled.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package openai
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAssistantDataSource(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAssistantDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.openai_assistant.by_id", "id", "openai_assistant.test", "id"),
+					resource.TestCheckResourceAttr("data.openai_assistant.by_id", "name", rName),
+					resource.TestCheckResourceAttr("data.openai_assistant.by_id", "model", "gpt-4"),
+					resource.TestCheckResourceAttrSet("data.openai_assistant.by_id", "created_at"),
+					resource.TestCheckResourceAttrPair("data.openai_assistant.by_name", "id", "openai_assistant.test", "id"),
+					resource.TestCheckResourceAttr("data.openai_assistant.by_name", "description", "test description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssistantDataSourceConfig(rName string) string {
+	return testAccAssistantResourceConfig_tool_simple(rName, "test description") + fmt.Sprintf(`
+data "openai_assistant" "by_id" {
+	id = openai_assistant.test.id
+}
+
+data "openai_assistant" "by_name" {
+	name = %[1]q
+
+	depends_on = [openai_assistant.test]
+}
+`, rName)
+}
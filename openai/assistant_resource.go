@@ -2,15 +2,19 @@ package openai
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +24,15 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AssistantResource{}
 var _ resource.ResourceWithImportState = &AssistantResource{}
+var _ resource.ResourceWithValidateConfig = &AssistantResource{}
+var _ resource.ResourceWithModifyPlan = &AssistantResource{}
+
+// The API defaults of the sampling parameters.
+const (
+	assistantDefaultTemperature     = 1.0
+	assistantDefaultTopP            = 1.0
+	assistantDefaultReasoningEffort = "medium"
+)
 
 func NewAssistantResource() resource.Resource {
 	return &AssistantResource{OpenAIResource: &OpenAIResource{}}
@@ -55,27 +68,59 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the assistant. The maximum length is 256 characters.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(maxNameLength),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the assistant. The maximum length is 512 characters.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(maxAssistantDescriptionLength),
+				},
 			},
 			"model": schema.StringAttribute{
 				MarkdownDescription: "ID of the model to use. You can use the List models API to see all of your available models.",
 				Required:            true,
 			},
 			"instructions": schema.StringAttribute{
-				MarkdownDescription: "The system instructions that the assistant uses. The maximum length is 32768 characters.",
+				MarkdownDescription: "The system instructions that the assistant uses. The maximum length is 32768 characters. Conflicts with `instructions_file`, which sets this attribute when used.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(maxAssistantInstructionsLength),
+				},
+			},
+			"instructions_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the system instructions, relative to the working directory. The content is rendered with `template_vars` when set. Conflicts with `instructions`.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("instructions")),
+				},
+			},
+			"template_vars": schema.MapAttribute{
+				MarkdownDescription: "Variables used to render `instructions_file` and `parameters_file` as Go templates, for example `{{ .audience }}`. When not set the files are used as is.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"source_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 of the content rendered from `instructions_file` and `parameters_file`. Changes whenever a source file or `template_vars` change.",
+				Computed:            true,
 			},
 			"tools": schema.ListNestedAttribute{
-				MarkdownDescription: "A list of tool enabled on the assistant. There can be a maximum of 128 tools per assistant. Tools can be of types code_interpreter, retrieval, or function.",
+				MarkdownDescription: "A list of tool enabled on the assistant. There can be a maximum of 128 tools per assistant. Tools can be of types code_interpreter, file_search, or function.",
 				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(maxAssistantTools),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"type": schema.StringAttribute{
-							MarkdownDescription: "Tools can be of types code_interpreter, retrieval, or function.",
+							MarkdownDescription: "Tools can be of types code_interpreter, file_search, or function.",
 							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("code_interpreter", "file_search", "function"),
+							},
 						},
 						"function": schema.SingleNestedAttribute{
 							MarkdownDescription: "Function definition for tools of type function.",
@@ -88,10 +133,57 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 								"name": schema.StringAttribute{
 									MarkdownDescription: "The name of the function to be called. Must be a-z, A-Z, 0-9, or contain underscores and dashes, with a maximum length of 64.",
 									Required:            true,
+									Validators:          functionNameValidators(),
 								},
 								"parameters": schema.StringAttribute{
-									MarkdownDescription: "The parameters the functions accepts, described as a JSON Schema object.",
-									Required:            true,
+									CustomType:          JSONStringType{},
+									MarkdownDescription: "The parameters the functions accepts, described as a JSON Schema object. Exactly one of `parameters` or `parameters_file` must be set.",
+									Optional:            true,
+									Computed:            true,
+								},
+								"parameters_file": schema.StringAttribute{
+									MarkdownDescription: "Path to a file holding the parameters as a JSON Schema object, relative to the working directory. The content is rendered with `template_vars` when set.",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("parameters")),
+									},
+								},
+							},
+						},
+						"file_search": schema.SingleNestedAttribute{
+							MarkdownDescription: "Overrides for tools of type file_search.",
+							Optional:            true,
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"max_num_results": schema.Int64Attribute{
+									MarkdownDescription: "The maximum number of results the file search tool should output. The default is 20 for gpt-4* models and 5 for gpt-3.5-turbo. This number should be between 1 and 50 inclusive.",
+									Optional:            true,
+									Computed:            true,
+									Validators: []validator.Int64{
+										int64validator.Between(1, 50),
+									},
+								},
+								"ranking_options": schema.SingleNestedAttribute{
+									MarkdownDescription: "The ranking options for the file search. If not specified, the file search tool will use the auto ranker and a score_threshold of 0.",
+									Optional:            true,
+									Computed:            true,
+									Attributes: map[string]schema.Attribute{
+										"ranker": schema.StringAttribute{
+											MarkdownDescription: "The ranker to use for the file search. If not specified will use the auto ranker.",
+											Optional:            true,
+											Computed:            true,
+											Validators: []validator.String{
+												stringvalidator.OneOf("auto", "default_2024_08_21"),
+											},
+										},
+										"score_threshold": schema.Float64Attribute{
+											MarkdownDescription: "The score threshold for the file search. All values must be a floating point number between 0 and 1.",
+											Required:            true,
+											Validators: []validator.Float64{
+												float64validator.Between(0, 1),
+											},
+										},
+									},
 								},
 							},
 						},
@@ -112,6 +204,9 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 								ElementType:         types.StringType,
 								Optional:            true,
 								Computed:            true,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(20),
+								},
 							},
 						},
 					},
@@ -124,6 +219,9 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 								ElementType:         types.StringType,
 								Optional:            true,
 								Computed:            true,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								PlanModifiers: []planmodifier.List{
 									listplanmodifier.RequiresReplace(),
 								},
@@ -136,16 +234,31 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "Set of 16 key-value pairs that can be attached to an object. This can be useful for storing additional information about the object in a structured format. Keys can be a maximum of 64 characters long and values can be a maxium of 512 characters long.",
 				ElementType:         types.StringType,
 				Optional:            true,
+				Validators:          metadataValidators(),
 			},
 			"temperature": schema.Float64Attribute{
-				MarkdownDescription: "What sampling temperature to use, between 0 and 2. Higher values like 0.8 will make the output more random, while lower values like 0.2 will make it more focused and deterministic.",
+				MarkdownDescription: "What sampling temperature to use, between 0 and 2. Higher values like 0.8 will make the output more random, while lower values like 0.2 will make it more focused and deterministic. Removing it resets it to the default of 1.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 2),
+				},
 			},
 			"top_p": schema.Float64Attribute{
-				MarkdownDescription: "An alternative to sampling with temperature, called nucleus sampling, where the model considers the results of the tokens with top_p probability mass. So 0.1 means only the tokens comprising the top 10% probability mass are considered.",
+				MarkdownDescription: "An alternative to sampling with temperature, called nucleus sampling, where the model considers the results of the tokens with top_p probability mass. So 0.1 means only the tokens comprising the top 10% probability mass are considered. Removing it resets it to the default of 1.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"reasoning_effort": schema.StringAttribute{
+				MarkdownDescription: "Constrains effort on reasoning for reasoning models (o1, o3, o4 and gpt-5 families). Supported values are low, medium and high. Reasoning models do not support temperature or top_p. Removing it resets it to the default of medium.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("low", "medium", "high"),
+				},
 			},
 			"response_format": schema.SingleNestedAttribute{
 				MarkdownDescription: "Specifies the format that the model must output. Compatible with GPT-4o, GPT-4 Turbo, and all GPT-3.5 Turbo models since gpt-3.5-turbo-1106.",
@@ -154,6 +267,9 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 					"type": schema.StringAttribute{
 						MarkdownDescription: "Setting to {\"type\": \"json_schema\", \"json_schema\": {...} } enables Structured Outputs which ensures the model will match your supplied JSON schema. Setting to { \"type\": \"json_object\" } enables JSON mode, which ensures the message the model generates is valid JSON.",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("text", "json_object", "json_schema"),
+						},
 					},
 					"json_schema": schema.SingleNestedAttribute{
 						MarkdownDescription: "Specifies the format that the model must output. Compatible with GPT-4o, GPT-4 Turbo, and all GPT-3.5 Turbo models since gpt-3.5-turbo-1106.",
@@ -164,10 +280,12 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 								Optional:            true,
 							},
 							"name": schema.StringAttribute{
-								MarkdownDescription: "The parameters the functions accepts, described as a JSON Schema object.",
+								MarkdownDescription: "The name of the response format. Must be a-z, A-Z, 0-9, or contain underscores and dashes, with a maximum length of 64.",
 								Required:            true,
+								Validators:          functionNameValidators(),
 							},
 							"schema": schema.StringAttribute{
+								CustomType:          JSONStringType{},
 								MarkdownDescription: "The schema for the response format, described as a JSON Schema object.",
 								Required:            true,
 							},
@@ -184,8 +302,95 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r *AssistantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OpenAIAssistantSourcedResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Model.IsNull() || data.Model.IsUnknown() {
+		return
+	}
+	model := data.Model.ValueString()
+
+	if isReasoningModel(model) {
+		sampling := []struct {
+			name  string
+			value types.Float64
+		}{
+			{"temperature", data.Temperature},
+			{"top_p", data.TopP},
+		}
+		for _, p := range sampling {
+			if !p.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(p.name),
+					"Unsupported Attribute For Reasoning Model",
+					fmt.Sprintf("The model %q is a reasoning model, which does not support %s. Use reasoning_effort instead.", model, p.name),
+				)
+			}
+		}
+	} else if !data.ReasoningEffort.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reasoning_effort"),
+			"Unsupported Attribute For Model",
+			fmt.Sprintf("The model %q is not a reasoning model, so reasoning_effort cannot be set.", model),
+		)
+	}
+}
+
+func (r *AssistantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to render on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config OpenAIAssistantSourcedResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	var prior *OpenAIAssistantSourcedResourceModel
+	if !req.State.Raw.IsNull() {
+		prior = &OpenAIAssistantSourcedResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// instructions is only computed when it is rendered from instructions_file.
+	if plan.InstructionsFile.IsNull() {
+		plan.Instructions = config.Instructions
+	}
+
+	// The API keeps the sampling parameters that are not sent, so removing
+	// one from the configuration plans its default to reset it.
+	if prior != nil && !plan.Model.IsUnknown() {
+		if isReasoningModel(plan.Model.ValueString()) {
+			if config.ReasoningEffort.IsNull() && !prior.ReasoningEffort.IsNull() {
+				plan.ReasoningEffort = types.StringValue(assistantDefaultReasoningEffort)
+			}
+		} else {
+			if config.Temperature.IsNull() && !prior.Temperature.IsNull() {
+				plan.Temperature = types.Float64Value(assistantDefaultTemperature)
+			}
+			if config.TopP.IsNull() && !prior.TopP.IsNull() {
+				plan.TopP = types.Float64Value(assistantDefaultTopP)
+			}
+		}
+	}
+
+	// Render the source files during plan so that changes to their content show as a diff.
+	resp.Diagnostics.Append(resolveAssistantSources(ctx, &plan, prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *AssistantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data OpenAIAssistantResourceModel
+	var data OpenAIAssistantSourcedResourceModel
 	var diags diag.Diagnostics
 
 	// Read Terraform plan data into the model
@@ -197,34 +402,30 @@ func (r *AssistantResource) Create(ctx context.Context, req resource.CreateReque
 
 	tflog.Info(ctx, "Creating Assistant...")
 
-	aReq := openai.AssistantRequest{
-		Model:        data.Model.ValueString(),
-		Name:         data.Name.ValueStringPointer(),
-		Description:  data.Description.ValueStringPointer(),
-		Instructions: data.Instructions.ValueStringPointer(),
-		Temperature:  data.Temperature.ValueFloat64(),
-		TopP:         data.TopP.ValueFloat64(),
+	if data.SourceHash.IsUnknown() {
+		resp.Diagnostics.Append(resolveAssistantSources(ctx, &data, nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
+	plan := data
 
-	var toolModels []OpenAIAssistantToolModel
-	resp.Diagnostics.Append(data.Tools.ElementsAs(ctx, &toolModels, false)...)
+	aReq, diags := expandAssistantRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	aReq.Tools = expandAssistantTools(toolModels)
-	aReq.ToolResources = expandAssistantToolResources(ctx, data.ToolResources)
-	aReq.ResponseFormat = expandAssistantResponseFormat(data.ResponseFormat)
-
-	assistant, err := r.client.Assistants().CreateAssistant(&aReq)
+	assistant, err := r.client.WithContext(ctx).Assistants().CreateAssistant(aReq)
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to create assistant, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create assistant", err))
 		return
 	}
 	tflog.Info(ctx, "Assistant created successfully")
 
-	data, diags = NewOpenAIAssistantResourceModel(ctx, assistant)
+	data, diags = NewOpenAIAssistantSourcedResourceModel(ctx, assistant)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(copyAssistantSources(ctx, &data, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -233,7 +434,7 @@ func (r *AssistantResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *AssistantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data OpenAIAssistantResourceModel
+	var data OpenAIAssistantSourcedResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -243,14 +444,16 @@ func (r *AssistantResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Reading Assistant with id: %s", data.Id.ValueString()))
-	assistant, err := r.client.Assistants().RetrieveAssistant(data.Id.ValueString())
+	assistant, err := r.client.WithContext(ctx).Assistants().RetrieveAssistant(data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to retrieve assistant, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve assistant", err))
 		return
 	}
 
-	data, diags := NewOpenAIAssistantResourceModel(ctx, assistant)
+	state := data
+	data, diags := NewOpenAIAssistantSourcedResourceModel(ctx, assistant)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(copyAssistantSources(ctx, &data, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -260,13 +463,13 @@ func (r *AssistantResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *AssistantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data OpenAIAssistantResourceModel
+	var data OpenAIAssistantSourcedResourceModel
 	var diags diag.Diagnostics
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	var state OpenAIAssistantResourceModel
+	var state OpenAIAssistantSourcedResourceModel
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -276,33 +479,30 @@ func (r *AssistantResource) Update(ctx context.Context, req resource.UpdateReque
 
 	tflog.Info(ctx, fmt.Sprintf("Updating Assistant: %s", state.Id.ValueString()))
 
-	aReq := openai.AssistantRequest{
-		Model:        data.Model.ValueString(),
-		Name:         data.Name.ValueStringPointer(),
-		Description:  data.Description.ValueStringPointer(),
-		Instructions: data.Instructions.ValueStringPointer(),
-		Temperature:  data.Temperature.ValueFloat64(),
-		TopP:         data.TopP.ValueFloat64(),
+	if data.SourceHash.IsUnknown() {
+		resp.Diagnostics.Append(resolveAssistantSources(ctx, &data, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
+	plan := data
 
-	var toolModels []OpenAIAssistantToolModel
-	resp.Diagnostics.Append(data.Tools.ElementsAs(ctx, &toolModels, false)...)
+	aReq, diags := expandAssistantRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	aReq.Tools = expandAssistantTools(toolModels)
-	aReq.ToolResources = expandAssistantToolResources(ctx, data.ToolResources)
-
-	assistant, err := r.client.Assistants().ModifyAssistant(state.Id.ValueString(), &aReq)
+	assistant, err := r.client.WithContext(ctx).Assistants().ModifyAssistant(state.Id.ValueString(), aReq)
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to modify assistant, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to modify assistant", err))
 		return
 	}
 	tflog.Info(ctx, "Assistant modified successfully")
 
-	data, diags = NewOpenAIAssistantResourceModel(ctx, assistant)
+	data, diags = NewOpenAIAssistantSourcedResourceModel(ctx, assistant)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(copyAssistantSources(ctx, &data, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -311,7 +511,7 @@ func (r *AssistantResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *AssistantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data OpenAIAssistantResourceModel
+	var data OpenAIAssistantSourcedResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -322,14 +522,9 @@ func (r *AssistantResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	// Delete the assistant
 	tflog.Info(ctx, fmt.Sprintf("Deleting Assistant: %s", data.Id.ValueString()))
-	bDeleted, err := r.client.Assistants().DeleteAssistant(data.Id.ValueString())
+	bDeleted, err := r.client.WithContext(ctx).Assistants().DeleteAssistant(data.Id.ValueString())
 	if err != nil {
-		if err, ok := err.(*openai.APIError); ok {
-			fmt.Println("openai error:", err.Code)
-			// Or whatever other field(s) you need
-		}
-
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to delete assistant, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to delete assistant", err))
 		return
 	}
 	if !bDeleted {
@@ -342,36 +537,113 @@ func (r *AssistantResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func expandAssistantTools(tfList []OpenAIAssistantToolModel) []openai.AssistantTool {
+// expandAssistantRequest builds the request sent on both create and update.
+// Every configurable attribute is sent, including empty values, so that
+// removing an attribute from the configuration also clears it in the API.
+// The sampling parameters are the exception, as they are only sent when
+// known, and ModifyPlan plans their defaults when they are removed.
+func expandAssistantRequest(ctx context.Context, data *OpenAIAssistantSourcedResourceModel) (*AssistantRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	aReq := &AssistantRequest{
+		Model:       data.Model.ValueString(),
+		Name:        data.Name.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+	}
+	if !data.Instructions.IsUnknown() {
+		aReq.Instructions = data.Instructions.ValueStringPointer()
+	}
+
+	// Sampling parameters are rejected by reasoning models, so they are only
+	// sent when configured.
+	if !data.Temperature.IsNull() && !data.Temperature.IsUnknown() {
+		aReq.Temperature = data.Temperature.ValueFloat64Pointer()
+	}
+	if !data.TopP.IsNull() && !data.TopP.IsUnknown() {
+		aReq.TopP = data.TopP.ValueFloat64Pointer()
+	}
+	if !data.ReasoningEffort.IsNull() && !data.ReasoningEffort.IsUnknown() {
+		aReq.ReasoningEffort = data.ReasoningEffort.ValueStringPointer()
+	}
+
+	var toolModels []OpenAIAssistantToolModel
+	diags.Append(data.Tools.ElementsAs(ctx, &toolModels, false)...)
+	diags.Append(data.Metadata.ElementsAs(ctx, &aReq.MetaData, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if aReq.MetaData == nil {
+		aReq.MetaData = map[string]string{}
+	}
+	var toolDiags, responseFormatDiags diag.Diagnostics
+	aReq.Tools, toolDiags = expandAssistantTools(ctx, toolModels)
+	diags.Append(toolDiags...)
+	if aReq.Tools == nil {
+		aReq.Tools = []AssistantTool{}
+	}
+	aReq.ToolResources = expandAssistantToolResources(ctx, data.ToolResources)
+	if aReq.ToolResources == nil {
+		aReq.ToolResources = &openai.AssistantToolResources{}
+	}
+	aReq.ResponseFormat, responseFormatDiags = expandAssistantResponseFormat(data.ResponseFormat)
+	diags.Append(responseFormatDiags...)
+
+	return aReq, diags
+}
+
+func expandAssistantTools(ctx context.Context, tfList []OpenAIAssistantToolModel) ([]AssistantTool, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	if len(tfList) == 0 {
-		return nil
+		return nil, diags
 	}
-	var tools []openai.AssistantTool
+	var tools []AssistantTool
 
-	for _, item := range tfList {
-		tool := openai.AssistantTool{
+	for i, item := range tfList {
+		tool := AssistantTool{
 			Type: item.Type.ValueString(),
 		}
 		if item.Function != nil {
-			tool.Function = &struct {
-				Description *string                "json:\"description,omitempty\""
-				Name        string                 "json:\"name\""
-				Parameters  map[string]interface{} "json:\"parameters\""
-			}{
+			tool.Function = &AssistantToolFunction{
 				Description: item.Function.Description.ValueStringPointer(),
 				Name:        *item.Function.Name.ValueStringPointer(),
 			}
 			if !item.Function.Parameters.IsNull() {
 				// Unmarshal the JSON string into the struct
-				err := json.Unmarshal([]byte(item.Function.Parameters.ValueString()), &tool.Function.Parameters)
+				err := item.Function.Parameters.Unmarshal(&tool.Function.Parameters)
 				if err != nil {
-					fmt.Println(err)
+					diags.AddAttributeError(path.Root("tools").AtListIndex(i).AtName("function").AtName("parameters"), "Invalid Function Parameters", fmt.Sprintf("Unable to decode parameters, got error: %s", err))
 				}
 			}
 		}
+		tool.FileSearch = expandAssistantToolFileSearch(ctx, item.FileSearch)
 		tools = append(tools, tool)
 	}
-	return tools
+	return tools, diags
+}
+
+func expandAssistantToolFileSearch(ctx context.Context, obj types.Object) *AssistantToolFileSearch {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	model := OpenAIAssistantToolFileSearchModel{}
+	obj.As(ctx, &model, basetypes.ObjectAsOptions{})
+
+	fileSearch := &AssistantToolFileSearch{}
+	if !model.MaxNumResults.IsNull() && !model.MaxNumResults.IsUnknown() {
+		fileSearch.MaxNumResults = model.MaxNumResults.ValueInt64Pointer()
+	}
+	if !model.RankingOptions.IsNull() && !model.RankingOptions.IsUnknown() {
+		rankingOptions := OpenAIAssistantToolFileSearchRankingOptionsModel{}
+		model.RankingOptions.As(ctx, &rankingOptions, basetypes.ObjectAsOptions{})
+		fileSearch.RankingOptions = &AssistantToolFileSearchRankingOptions{
+			ScoreThreshold: rankingOptions.ScoreThreshold.ValueFloat64(),
+		}
+		if !rankingOptions.Ranker.IsNull() && !rankingOptions.Ranker.IsUnknown() {
+			fileSearch.RankingOptions.Ranker = rankingOptions.Ranker.ValueStringPointer()
+		}
+	}
+	return fileSearch
 }
 
 func expandAssistantToolResources(ctx context.Context, model *OpenAIAssistantToolResourcesModel) *openai.AssistantToolResources {
@@ -403,13 +675,15 @@ func expandAssistantToolResources(ctx context.Context, model *OpenAIAssistantToo
 	return toolResources
 }
 
-func expandAssistantResponseFormat(model *OpenAIAssistantResponseFormatModel) *openai.AssistantResponseFormat {
+func expandAssistantResponseFormat(model *OpenAIAssistantResponseFormatModel) (*AssistantResponseFormat, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	if model == nil {
-		return nil
-	}
-	responseFormat := &openai.AssistantResponseFormat{
-		Type: model.Type.ValueString(),
+		return &AssistantResponseFormat{
+			AssistantResponseFormat: openai.AssistantResponseFormat{StringValue: "auto"},
+		}, diags
 	}
+	responseFormat := &AssistantResponseFormat{}
+	responseFormat.Type = model.Type.ValueString()
 	if model.JsonSchema != nil {
 		responseFormat.JsonSchema = &struct {
 			Description *string                `json:"description,omitempty"`
@@ -423,11 +697,11 @@ func expandAssistantResponseFormat(model *OpenAIAssistantResponseFormatModel) *o
 		}
 		if !model.JsonSchema.Schema.IsNull() {
 			// Unmarshal the JSON string into the struct
-			err := json.Unmarshal([]byte(model.JsonSchema.Schema.ValueString()), &responseFormat.JsonSchema.Schema)
+			err := model.JsonSchema.Schema.Unmarshal(&responseFormat.JsonSchema.Schema)
 			if err != nil {
-				fmt.Println(err)
+				diags.AddAttributeError(path.Root("response_format").AtName("json_schema").AtName("schema"), "Invalid JSON Schema", fmt.Sprintf("Unable to decode schema, got error: %s", err))
 			}
 		}
 	}
-	return responseFormat
+	return responseFormat, diags
 }
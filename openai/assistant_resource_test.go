@@ -1,16 +1,21 @@
 package openai
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/YakDriver/regexache"
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccAssistantResource_tool_simple(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	assistantResourceName := "openai_assistant.test"
 
 	resource.Test(t, resource.TestCase{
@@ -52,7 +57,7 @@ func TestAccAssistantResource_tool_simple(t *testing.T) {
 }
 
 func TestAccAssistantResource_tool_code_interpreter(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	assistantResourceName := "openai_assistant.test"
 
 	resource.Test(t, resource.TestCase{
@@ -94,7 +99,7 @@ func TestAccAssistantResource_tool_code_interpreter(t *testing.T) {
 }
 
 func TestAccAssistantResource_tool_function(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	assistantResourceName := "openai_assistant.test"
 
 	resource.Test(t, resource.TestCase{
@@ -135,8 +140,34 @@ func TestAccAssistantResource_tool_function(t *testing.T) {
 	})
 }
 
+func TestAccAssistantResource_tool_function_heredoc(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+	assistantResourceName := "openai_assistant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssistantResourceConfig_tool_function_heredoc(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(assistantResourceName, "id"),
+					resource.TestCheckResourceAttr(assistantResourceName, "tools.0.function.name", "get_weather"),
+				),
+			},
+			// Hand-written JSON must not drift from the JSON returned by the API
+			{
+				Config:   testAccAssistantResourceConfig_tool_function_heredoc(rName),
+				PlanOnly: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccAssistantResource_file_search(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	assistantResourceName := "openai_assistant.test"
 
 	resource.Test(t, resource.TestCase{
@@ -177,8 +208,46 @@ func TestAccAssistantResource_file_search(t *testing.T) {
 	})
 }
 
+func TestAccAssistantResource_file_search_options(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+	assistantResourceName := "openai_assistant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssistantResourceConfig_tool_file_search_options(rName, 10, "0.5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(assistantResourceName, "id"),
+					resource.TestCheckResourceAttr(assistantResourceName, "tools.0.type", "file_search"),
+					resource.TestCheckResourceAttr(assistantResourceName, "tools.0.file_search.max_num_results", "10"),
+					resource.TestCheckResourceAttr(assistantResourceName, "tools.0.file_search.ranking_options.ranker", "default_2024_08_21"),
+					resource.TestCheckResourceAttr(assistantResourceName, "tools.0.file_search.ranking_options.score_threshold", "0.5"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccAssistantResourceConfig_tool_file_search_options(rName, 5, "0.25"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(assistantResourceName, "tools.0.file_search.max_num_results", "5"),
+					resource.TestCheckResourceAttr(assistantResourceName, "tools.0.file_search.ranking_options.score_threshold", "0.25"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      assistantResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccAssistantResource_file_search_badfiletype(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -195,7 +264,7 @@ func TestAccAssistantResource_file_search_badfiletype(t *testing.T) {
 }
 
 func TestAccAssistantResource_response_format_json_object(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	assistantResourceName := "openai_assistant.test"
 
 	resource.Test(t, resource.TestCase{
@@ -223,7 +292,7 @@ func TestAccAssistantResource_response_format_json_object(t *testing.T) {
 }
 
 func TestAccAssistantResource_response_format_json_schema(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	assistantResourceName := "openai_assistant.test"
 
 	resource.Test(t, resource.TestCase{
@@ -250,8 +319,76 @@ func TestAccAssistantResource_response_format_json_schema(t *testing.T) {
 	})
 }
 
+func TestAccAssistantResource_reasoning_effort(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+	assistantResourceName := "openai_assistant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssistantResourceConfig_reasoning_effort(rName, "temperature = 0.5"),
+				ExpectError: regexache.MustCompile(`Unsupported Attribute For Reasoning Model`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccAssistantResourceConfig_reasoning_effort(rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(assistantResourceName, "id"),
+					resource.TestCheckResourceAttr(assistantResourceName, "model", "o3-mini"),
+					resource.TestCheckResourceAttr(assistantResourceName, "reasoning_effort", "high"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      assistantResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAssistantResource_instructions_file(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+	assistantResourceName := "openai_assistant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssistantResourceConfig_instructions_file(rName, "students"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(assistantResourceName, "id"),
+					resource.TestCheckResourceAttr(assistantResourceName, "instructions_file", "test-fixtures/instructions.md"),
+					resource.TestMatchResourceAttr(assistantResourceName, "instructions", regexache.MustCompile(`tutor for students\.`)),
+					resource.TestCheckResourceAttr(assistantResourceName, "tools.0.function.parameters_file", "test-fixtures/get_weather.json"),
+					resource.TestCheckResourceAttrSet(assistantResourceName, "source_hash"),
+				),
+			},
+			// The rendered content does not drift
+			{
+				Config:   testAccAssistantResourceConfig_instructions_file(rName, "students"),
+				PlanOnly: true,
+			},
+			// Update testing
+			{
+				Config: testAccAssistantResourceConfig_instructions_file(rName, "teachers"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(assistantResourceName, "instructions", regexache.MustCompile(`tutor for teachers\.`)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccAssistantResource_complex(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	assistantResourceName := "openai_assistant.test"
 
 	resource.Test(t, resource.TestCase{
@@ -352,6 +489,48 @@ resource openai_assistant test {
 `, filename, rName, description)
 }
 
+func testAccAssistantResourceConfig_tool_function_heredoc(rName string) string {
+	return fmt.Sprintf(`
+resource openai_assistant test {
+	name = %[1]q
+	model = "gpt-4o-mini"
+	tools = [
+		{
+			type = "function"
+			function = {
+				name = "get_weather"
+				parameters = <<EOT
+{
+  "type": "object",
+  "properties": {
+    "location": { "type": "string" },
+    "unit": { "type": "string", "enum": ["c", "f"] }
+  },
+  "required": ["location"]
+}
+EOT
+			}
+		}
+	]
+	response_format = {
+		type = "json_schema"
+		json_schema = {
+			name = "weather"
+			schema = <<EOT
+{
+  "type": "object",
+  "required": ["temperature"],
+  "properties": { "temperature": { "type": "number" } },
+  "additionalProperties": false
+}
+EOT
+			strict = true
+		}
+	}
+}
+`, rName)
+}
+
 func testAccAssistantResourceConfig_tool_file_search(filename string, rName string, description string) string {
 	return fmt.Sprintf(`	
 resource openai_file test {
@@ -384,6 +563,27 @@ resource openai_assistant test {
 `, filename, rName, description)
 }
 
+func testAccAssistantResourceConfig_tool_file_search_options(rName string, maxNumResults int, scoreThreshold string) string {
+	return fmt.Sprintf(`
+resource openai_assistant test {
+	name = %[1]q
+	model = "gpt-4o"
+	tools = [
+		{
+			type = "file_search"
+			file_search = {
+				max_num_results = %[2]d
+				ranking_options = {
+					ranker = "default_2024_08_21"
+					score_threshold = %[3]s
+				}
+			}
+		}
+	]
+}
+`, rName, maxNumResults, scoreThreshold)
+}
+
 func testAccAssistantResourceConfig_tool_complex(filename string, rName string, description string, temperature string) string {
 	return fmt.Sprintf(`	
 resource openai_file test {
@@ -437,6 +637,41 @@ resource openai_assistant test {
 `, rName)
 }
 
+func testAccAssistantResourceConfig_reasoning_effort(rName string, extra string) string {
+	return fmt.Sprintf(`
+resource openai_assistant test {
+	name = %[1]q
+	model = "o3-mini"
+	instructions = "You are a personal math tutor."
+	reasoning_effort = "high"
+	%[2]s
+}
+`, rName, extra)
+}
+
+func testAccAssistantResourceConfig_instructions_file(rName string, audience string) string {
+	return fmt.Sprintf(`
+resource openai_assistant test {
+	name = %[1]q
+	model = "gpt-4o-mini"
+	instructions_file = "test-fixtures/instructions.md"
+	template_vars = {
+		audience = %[2]q
+		unit     = "celsius"
+	}
+	tools = [
+		{
+			type = "function"
+			function = {
+				name            = "get_weather"
+				parameters_file = "test-fixtures/get_weather.json"
+			}
+		}
+	]
+}
+`, rName, audience)
+}
+
 func testAccAssistantResourceConfig_response_format_json_schema(rName string) string {
 	return fmt.Sprintf(`	
 resource openai_assistant test {
@@ -462,3 +697,314 @@ resource openai_assistant test {
 }
 `, rName)
 }
+
+func TestAssistantToolsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	maxNumResults := int64(10)
+	ranker := "default_2024_08_21"
+	description := "Get the weather"
+	tools := []AssistantTool{
+		{Type: "code_interpreter"},
+		{
+			Type: "file_search",
+			FileSearch: &AssistantToolFileSearch{
+				MaxNumResults: &maxNumResults,
+				RankingOptions: &AssistantToolFileSearchRankingOptions{
+					Ranker:         &ranker,
+					ScoreThreshold: 0.5,
+				},
+			},
+		},
+		{
+			Type: "function",
+			Function: &AssistantToolFunction{
+				Description: &description,
+				Name:        "get_weather",
+				Parameters:  map[string]interface{}{"type": "object"},
+			},
+		},
+	}
+
+	model, diags := NewOpenAIAssistantResourceModel(ctx, &Assistant{Id: "asst_123", Model: "gpt-4o", Tools: tools})
+	assert.False(t, diags.HasError())
+
+	var toolModels []OpenAIAssistantToolModel
+	assert.False(t, model.Tools.ElementsAs(ctx, &toolModels, false).HasError())
+	assert.True(t, toolModels[0].FileSearch.IsNull())
+	expanded, diags := expandAssistantTools(ctx, toolModels)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, tools, expanded)
+}
+
+func TestAssistantResource_RequestBody(t *testing.T) {
+	ctx := context.Background()
+	body := `{
+		"id": "asst_123",
+		"object": "assistant",
+		"name": "tf-test",
+		"description": "tf-test description",
+		"model": "gpt-4o",
+		"instructions": "You are a personal math tutor.",
+		"tools": [
+			{"type": "code_interpreter"},
+			{"type": "function", "function": {"name": "get_weather", "parameters": {"type": "object"}}}
+		],
+		"tool_resources": {"code_interpreter": {"file_ids": ["file-123"]}},
+		"metadata": {"team": "maths"},
+		"temperature": 0.5,
+		"top_p": 0.9,
+		"response_format": {"type": "json_schema", "json_schema": {"name": "answer", "schema": {"type": "object"}, "strict": true}}
+	}`
+	var assistant *Assistant
+	assert.NoError(t, json.Unmarshal([]byte(body), &assistant))
+
+	client, recorder := newRecordingTestClient(t, body)
+	r := &AssistantResource{OpenAIResource: &OpenAIResource{client: client}}
+	s := resourceSchema(ctx, r)
+
+	model, diags := NewOpenAIAssistantSourcedResourceModel(ctx, assistant)
+	assert.False(t, diags.HasError())
+
+	expectRequest := func(t *testing.T, req recordedRequest) {
+		// gpt-4o is not a reasoning model, see TestAssistantResource_RequestBodyReasoningModel.
+		// The file source attributes are rendered into instructions and parameters.
+		assertRequestCoversSchema(t, s, req.Body, "reasoning_effort", "instructions_file", "template_vars")
+		assert.Equal(t, map[string]interface{}{"team": "maths"}, req.Body["metadata"])
+		assert.Equal(t, "json_schema", req.Body["response_format"].(map[string]interface{})["type"])
+		assert.Len(t, req.Body["tools"], 2)
+	}
+
+	createResp := fwresource.CreateResponse{State: newTestState(t, ctx, s, nil)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, ctx, s, &model)}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	req := recorder.last(t)
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "/v1/assistants", req.Path)
+	expectRequest(t, req)
+
+	updateResp := fwresource.UpdateResponse{State: newTestState(t, ctx, s, &model)}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: newTestPlan(t, ctx, s, &model), State: newTestState(t, ctx, s, &model)}, &updateResp)
+	assert.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+	req = recorder.last(t)
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "/v1/assistants/asst_123", req.Path)
+	expectRequest(t, req)
+}
+
+func TestAssistantResource_RequestBodyClearsOptionalFields(t *testing.T) {
+	ctx := context.Background()
+	client, recorder := newRecordingTestClient(t, `{"id": "asst_123", "object": "assistant", "model": "gpt-4o"}`)
+	r := &AssistantResource{OpenAIResource: &OpenAIResource{client: client}}
+	s := resourceSchema(ctx, r)
+
+	model, diags := NewOpenAIAssistantSourcedResourceModel(ctx, &Assistant{Id: "asst_123", Model: "gpt-4o"})
+	assert.False(t, diags.HasError())
+
+	updateResp := fwresource.UpdateResponse{State: newTestState(t, ctx, s, &model)}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: newTestPlan(t, ctx, s, &model), State: newTestState(t, ctx, s, &model)}, &updateResp)
+	assert.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+
+	req := recorder.last(t)
+	assert.Equal(t, map[string]interface{}{}, req.Body["metadata"])
+	assert.Equal(t, []interface{}{}, req.Body["tools"])
+	assert.Equal(t, map[string]interface{}{}, req.Body["tool_resources"])
+	assert.Equal(t, "auto", req.Body["response_format"])
+}
+
+func TestAssistantResource_RequestBodyReasoningModel(t *testing.T) {
+	ctx := context.Background()
+	body := `{"id": "asst_123", "object": "assistant", "model": "o3-mini", "reasoning_effort": "high"}`
+	var assistant *Assistant
+	assert.NoError(t, json.Unmarshal([]byte(body), &assistant))
+
+	client, recorder := newRecordingTestClient(t, body)
+	r := &AssistantResource{OpenAIResource: &OpenAIResource{client: client}}
+	s := resourceSchema(ctx, r)
+
+	model, diags := NewOpenAIAssistantSourcedResourceModel(ctx, assistant)
+	assert.False(t, diags.HasError())
+	model.Temperature = types.Float64Unknown()
+	model.TopP = types.Float64Unknown()
+
+	createResp := fwresource.CreateResponse{State: newTestState(t, ctx, s, nil)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, ctx, s, &model)}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	req := recorder.last(t)
+	assert.Equal(t, "high", req.Body["reasoning_effort"])
+	assert.NotContains(t, req.Body, "temperature")
+	assert.NotContains(t, req.Body, "top_p")
+}
+
+func TestAssistantResource_RequestBodyFromFiles(t *testing.T) {
+	ctx := context.Background()
+	body := `{
+		"id": "asst_123",
+		"object": "assistant",
+		"model": "gpt-4o",
+		"instructions": "rendered by the fake API",
+		"tools": [{"type": "function", "function": {"name": "get_weather", "parameters": {"type": "object"}}}]
+	}`
+	client, recorder := newRecordingTestClient(t, body)
+	r := &AssistantResource{OpenAIResource: &OpenAIResource{client: client}}
+	s := resourceSchema(ctx, r)
+
+	model, diags := NewOpenAIAssistantSourcedResourceModel(ctx, &Assistant{Model: "gpt-4o"})
+	assert.False(t, diags.HasError())
+	model.Id = types.StringUnknown()
+	model.Instructions = types.StringUnknown()
+	model.InstructionsFile = types.StringValue("test-fixtures/instructions.md")
+	model.TemplateVars, diags = types.MapValueFrom(ctx, types.StringType, map[string]string{"audience": "students", "unit": "celsius"})
+	assert.False(t, diags.HasError())
+	model.SourceHash = types.StringUnknown()
+	model.Tools, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: OpenAIAssistantToolModel{}.AttrTypes()}, []OpenAIAssistantToolModel{
+		{
+			Type: types.StringValue("function"),
+			Function: &OpenAIAssistantToolFunctionModel{
+				Name:           types.StringValue("get_weather"),
+				Parameters:     NewJSONStringUnknown(),
+				ParametersFile: types.StringValue("test-fixtures/get_weather.json"),
+			},
+			FileSearch: types.ObjectNull(OpenAIAssistantToolFileSearchModel{}.AttrTypes()),
+		},
+	})
+	assert.False(t, diags.HasError())
+
+	createResp := fwresource.CreateResponse{State: newTestState(t, ctx, s, nil)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, ctx, s, &model)}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	req := recorder.last(t)
+	assert.Contains(t, req.Body["instructions"], "You are a personal maths tutor for students.")
+	parameters := req.Body["tools"].([]interface{})[0].(map[string]interface{})["function"].(map[string]interface{})["parameters"]
+	assert.Equal(t, []interface{}{"celsius"}, parameters.(map[string]interface{})["properties"].(map[string]interface{})["unit"].(map[string]interface{})["enum"])
+
+	var state OpenAIAssistantSourcedResourceModel
+	assert.False(t, createResp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "test-fixtures/instructions.md", state.InstructionsFile.ValueString())
+	assert.Len(t, state.SourceHash.ValueString(), 64)
+	var tools []OpenAIAssistantToolModel
+	assert.False(t, state.Tools.ElementsAs(ctx, &tools, false).HasError())
+	assert.Equal(t, "test-fixtures/get_weather.json", tools[0].Function.ParametersFile.ValueString())
+}
+
+func TestResolveAssistantSources_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := NewOpenAIAssistantSourcedResourceModel(ctx, &Assistant{Model: "gpt-4o"})
+	assert.False(t, diags.HasError())
+
+	model.InstructionsFile = types.StringValue("test-fixtures/instructions.md")
+	model.TemplateVars, _ = types.MapValueFrom(ctx, types.StringType, map[string]string{"unit": "celsius"})
+	diags = resolveAssistantSources(ctx, &model, nil)
+	assert.True(t, diags.HasError(), "missing template variables are an error")
+
+	model.InstructionsFile = types.StringValue("test-fixtures/missing.md")
+	model.TemplateVars = types.MapNull(types.StringType)
+	diags = resolveAssistantSources(ctx, &model, nil)
+	assert.True(t, diags.HasError(), "missing files are an error")
+
+	model.InstructionsFile = types.StringValue("test-fixtures/instructions.md")
+	diags = resolveAssistantSources(ctx, &model, nil)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, model.Instructions.ValueString(), "{{ .audience }}", "files are used as is without template_vars")
+}
+
+func TestAssistantResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &AssistantResource{OpenAIResource: &OpenAIResource{}}
+	s := resourceSchema(ctx, r)
+
+	cases := []struct {
+		name            string
+		model           string
+		temperature     types.Float64
+		reasoningEffort types.String
+		errorPath       string
+	}{
+		{"sampling on chat model", "gpt-4o", types.Float64Value(0.5), types.StringNull(), ""},
+		{"reasoning effort on reasoning model", "o3-mini", types.Float64Null(), types.StringValue("low"), ""},
+		{"reasoning effort on chat model", "gpt-4o", types.Float64Null(), types.StringValue("low"), "reasoning_effort"},
+		{"temperature on reasoning model", "o1", types.Float64Value(0.5), types.StringNull(), "temperature"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			model, diags := NewOpenAIAssistantSourcedResourceModel(ctx, &Assistant{Model: c.model})
+			assert.False(t, diags.HasError())
+			model.Id = types.StringNull()
+			model.Object = types.StringNull()
+			model.CreatedAt = types.Int64Null()
+			model.Temperature = c.temperature
+			model.ReasoningEffort = c.reasoningEffort
+
+			resp := fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: newTestConfig(t, ctx, s, &model)}, &resp)
+			if c.errorPath == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			if assert.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics) {
+				assert.Equal(t, c.errorPath, resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path().String())
+			}
+		})
+	}
+}
+
+func TestIsReasoningModel(t *testing.T) {
+	assert.True(t, isReasoningModel("o1"))
+	assert.True(t, isReasoningModel("o3-mini"))
+	assert.True(t, isReasoningModel("o4-mini-2025-04-16"))
+	assert.True(t, isReasoningModel("gpt-5"))
+	assert.False(t, isReasoningModel("gpt-4o"))
+	assert.False(t, isReasoningModel("gpt-4o-mini"))
+	assert.False(t, isReasoningModel("omni-moderation-latest"))
+}
+
+func TestAssistantResource_Lifecycle(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("vector_stores", fakeObject{"id": "vs_abc", "object": "vector_store", "name": "faq", "status": "completed", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_assistant")
+
+	config := map[string]interface{}{
+		"name":         "support",
+		"model":        "gpt-4o",
+		"instructions": "Answer questions about the product.",
+		"tools": []interface{}{
+			map[string]interface{}{"type": "file_search"},
+			map[string]interface{}{"type": "function", "function": map[string]interface{}{
+				"name":       "get_order",
+				"parameters": `{"properties":{"id":{"type":"string"}},"type":"object"}`,
+			}},
+		},
+		"tool_resources": map[string]interface{}{
+			"file_search": map[string]interface{}{"vector_store_ids": []interface{}{"vs_abc"}},
+		},
+		"metadata": map[string]interface{}{"team": "support"},
+	}
+	l.apply(config)
+	id := l.attr("id")
+	assert.Equal(t, "support", fake.get("assistants", id)["name"])
+	assert.Len(t, fake.get("assistants", id)["tools"], 2)
+	l.assertNoChanges(config)
+
+	// Assistants are updated in place, and removed attributes are cleared.
+	config["name"] = "support-v2"
+	config["temperature"] = 0.2
+	delete(config, "metadata")
+	l.apply(config)
+	assert.Equal(t, id, l.attr("id"))
+	assert.Equal(t, "support-v2", fake.get("assistants", id)["name"])
+	assert.Equal(t, 0.2, fake.get("assistants", id)["temperature"])
+	l.assertNoChanges(config)
+
+	// Removing a sampling parameter resets it to the default.
+	delete(config, "temperature")
+	l.apply(config)
+	assert.Equal(t, 1.0, fake.get("assistants", id)["temperature"])
+	l.assertNoChanges(config)
+
+	l.importState(id)
+
+	l.destroy()
+	assert.Nil(t, fake.get("assistants", id))
+}
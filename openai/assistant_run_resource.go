@@ -0,0 +1,421 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AssistantRunResource{}
+var _ resource.ResourceWithValidateConfig = &AssistantRunResource{}
+
+// assistantRunWaitTimeout bounds how long a run may stay queued or in progress.
+const assistantRunWaitTimeout = 10 * time.Minute
+
+func NewAssistantRunResource() resource.Resource {
+	return &AssistantRunResource{OpenAIResource: &OpenAIResource{}}
+}
+
+// AssistantRunResource defines the resource implementation.
+type AssistantRunResource struct {
+	*OpenAIResource
+}
+
+func (r *AssistantRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assistant_run"
+}
+
+func (r *AssistantRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Assistant Run resource. Creates a thread with a single user message, runs it against an assistant and waits for the run to complete. " +
+			"The run is repeated when the prompt or the triggers change. To run it again when the assistant changes, add the assistant to the replace_triggered_by lifecycle argument. " +
+			"Use expect_regex or expect_json_schema to fail the apply when the output does not match.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the run.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"assistant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the assistant to use to execute this run.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prompt": schema.StringAttribute{
+				MarkdownDescription: "The user message to start the thread with.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the assistant again.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"expect_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the output must match.",
+				Optional:            true,
+			},
+			"expect_json_schema": schema.StringAttribute{
+				MarkdownDescription: "A JSON schema the output must be a valid JSON document for.",
+				CustomType:          JSONStringType{},
+				Optional:            true,
+			},
+			"thread_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the thread that was created for this run.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the run.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model that the assistant used for this run.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output": schema.StringAttribute{
+				MarkdownDescription: "The text of the final message the assistant added to the thread.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Usage statistics related to the run.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"prompt_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Number of prompt tokens used over the course of the run.",
+						Computed:            true,
+					},
+					"completion_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Number of completion tokens used over the course of the run.",
+						Computed:            true,
+					},
+					"total_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Total number of tokens used (prompt + completion).",
+						Computed:            true,
+					},
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the run was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the run was completed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AssistantRunResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OpenAIAssistantRunResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ExpectRegex.IsNull() && !data.ExpectRegex.IsUnknown() {
+		if _, err := regexp.Compile(data.ExpectRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expect_regex"), "Invalid Regular Expression", fmt.Sprintf("Unable to compile expect_regex, got error: %s", err))
+		}
+	}
+	if !data.ExpectJSONSchema.IsNull() && !data.ExpectJSONSchema.IsUnknown() {
+		if _, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(data.ExpectJSONSchema.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expect_json_schema"), "Invalid JSON Schema", fmt.Sprintf("Unable to load expect_json_schema, got error: %s", err))
+		}
+	}
+}
+
+func (r *AssistantRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OpenAIAssistantRunResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rReq := CreateThreadAndRunRequest{
+		AssistantId: data.AssistantId.ValueString(),
+		Thread: &CreateThreadRequest{
+			Messages: []ThreadMessageRequest{
+				{Role: "user", Content: data.Prompt.ValueString()},
+			},
+		},
+	}
+
+	tflog.Info(ctx, "Creating Assistant Run...")
+	run, err := r.client.WithContext(ctx).Threads().CreateThreadAndRun(&rReq)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create run", err))
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Assistant Run created: %s", run.Id))
+
+	// Save the run straight away so that the thread is deleted even if the run fails.
+	data.Output = types.StringNull()
+	data, diags = NewOpenAIAssistantRunResourceModel(ctx, run, data)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForRun(ctx, &data, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := r.retrieveRunOutput(ctx, data.ThreadId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve run output", err))
+		return
+	}
+	data.Output = types.StringValue(output)
+
+	// Save data into Terraform state before the assertions so a failed run is tainted.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(checkAssistantRunOutput(output, data.ExpectRegex, data.ExpectJSONSchema)...)
+}
+
+func (r *AssistantRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OpenAIAssistantRunResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading Assistant Run with id: %s", data.Id.ValueString()))
+	run, err := r.client.WithContext(ctx).Threads().RetrieveRun(data.ThreadId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Assistant Run does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve run", err))
+		return
+	}
+
+	data, diags = NewOpenAIAssistantRunResourceModel(ctx, run, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only changes the assertions, which are checked against the output of the existing run.
+func (r *AssistantRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OpenAIAssistantRunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	var state OpenAIAssistantRunResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The prior assertions stay in state when the new ones fail, so that they are checked again.
+	resp.Diagnostics.Append(checkAssistantRunOutput(state.Output.ValueString(), data.ExpectRegex, data.ExpectJSONSchema)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ExpectRegex = data.ExpectRegex
+	state.ExpectJSONSchema = data.ExpectJSONSchema
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AssistantRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OpenAIAssistantRunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Runs cannot be deleted. Delete the thread that was created for the run.
+	tflog.Info(ctx, fmt.Sprintf("Deleting Thread: %s", data.ThreadId.ValueString()))
+	_, err := r.client.WithContext(ctx).Threads().DeleteThread(data.ThreadId.ValueString())
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Thread does not exist")
+			return
+		}
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to delete thread", err))
+		return
+	}
+	tflog.Trace(ctx, "Thread deleted successfully")
+}
+
+// waitForRun polls the run until it completes, saving every refreshed run into state.
+// A run that requires action is cancelled, since function calls cannot be answered.
+func (r *AssistantRunResource) waitForRun(ctx context.Context, data *OpenAIAssistantRunResourceModel, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Waiting for run completion...")
+	threadId := data.ThreadId.ValueString()
+	runId := data.Id.ValueString()
+	var run *Run
+	err := retry.RetryContext(ctx, assistantRunWaitTimeout, func() *retry.RetryError {
+		var err error
+		run, err = r.client.WithContext(ctx).Threads().RetrieveRun(threadId, runId)
+		if err != nil {
+			// The client returns an empty run with the error, which must not overwrite the state.
+			run = nil
+			return retry.NonRetryableError(err)
+		}
+		switch run.Status {
+		case "completed":
+			return nil
+		case "queued", "in_progress", "cancelling":
+			tflog.Info(ctx, fmt.Sprintf("Run State: %s... Retrying...", run.Status))
+			return retry.RetryableError(fmt.Errorf("run still running"))
+		case "requires_action":
+			if _, err := r.client.WithContext(ctx).Threads().CancelRun(threadId, runId); err != nil {
+				return retry.NonRetryableError(fmt.Errorf("run requires action and could not be cancelled: %w", err))
+			}
+			return retry.NonRetryableError(fmt.Errorf("run requires action, function tool calls are not supported"))
+		default:
+			return retry.NonRetryableError(fmt.Errorf("unexpected run status: %s%s", run.Status, describeRunFailure(run)))
+		}
+	})
+	if run != nil {
+		var d diag.Diagnostics
+		*data, d = NewOpenAIAssistantRunResourceModel(ctx, run, *data)
+		diags.Append(d...)
+		diags.Append(state.Set(ctx, data)...)
+	}
+	if err != nil {
+		diags.Append(NewOpenAIErrorDiagnostic(fmt.Sprintf("Unable to wait for run %s", runId), err))
+	}
+	return diags
+}
+
+func describeRunFailure(run *Run) string {
+	if run.LastError != nil {
+		return fmt.Sprintf(" (%s: %s)", run.LastError.Code, run.LastError.Message)
+	}
+	if run.IncompleteDetails != nil {
+		return fmt.Sprintf(" (%s)", run.IncompleteDetails.Reason)
+	}
+	return ""
+}
+
+// retrieveRunOutput returns the text of the last assistant message added by the run.
+func (r *AssistantRunResource) retrieveRunOutput(ctx context.Context, threadId string, runId string) (string, error) {
+	order := "desc"
+	messages, err := r.client.WithContext(ctx).Threads().ListMessages(threadId, &ListThreadMessagesRequest{RunId: &runId, Order: &order})
+	if err != nil {
+		return "", err
+	}
+	for _, m := range messages.Data {
+		if m.Role != "assistant" {
+			continue
+		}
+		var text []string
+		for _, c := range m.Content {
+			if c.Type == "text" && c.Text != nil {
+				text = append(text, c.Text.Value)
+			}
+		}
+		return strings.Join(text, "\n"), nil
+	}
+	return "", fmt.Errorf("run %s did not add an assistant message", runId)
+}
+
+// checkAssistantRunOutput checks the output against the expect_regex and expect_json_schema assertions.
+func checkAssistantRunOutput(output string, expectRegex types.String, expectJSONSchema JSONStringValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !expectRegex.IsNull() {
+		re, err := regexp.Compile(expectRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("expect_regex"), "Invalid Regular Expression", fmt.Sprintf("Unable to compile expect_regex, got error: %s", err))
+		} else if !re.MatchString(output) {
+			diags.AddAttributeError(path.Root("expect_regex"), "Assistant Run Assertion Failed", fmt.Sprintf("The run output does not match %q. Output:\n%s", expectRegex.ValueString(), output))
+		}
+	}
+
+	if !expectJSONSchema.IsNull() {
+		result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(expectJSONSchema.ValueString()), gojsonschema.NewStringLoader(output))
+		if err != nil {
+			diags.AddAttributeError(path.Root("expect_json_schema"), "Assistant Run Assertion Failed", fmt.Sprintf("Unable to validate the run output against expect_json_schema, got error: %s. Output:\n%s", err, output))
+		} else if !result.Valid() {
+			var errors []string
+			for _, e := range result.Errors() {
+				errors = append(errors, e.String())
+			}
+			diags.AddAttributeError(path.Root("expect_json_schema"), "Assistant Run Assertion Failed", fmt.Sprintf("The run output does not match expect_json_schema: %s. Output:\n%s", strings.Join(errors, "; "), output))
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,259 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAssistantRunResource(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+	runResourceName := "openai_assistant_run.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssistantRunResourceConfig(rName, "Reply with the word pong and nothing else.", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(runResourceName, "id"),
+					resource.TestCheckResourceAttrSet(runResourceName, "thread_id"),
+					resource.TestCheckResourceAttr(runResourceName, "status", "completed"),
+					resource.TestCheckResourceAttrSet(runResourceName, "output"),
+					resource.TestCheckResourceAttrSet(runResourceName, "usage.total_tokens"),
+				),
+			},
+			// Replace on trigger change testing
+			{
+				Config: testAccAssistantRunResourceConfig(rName, "Reply with the word pong and nothing else.", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(runResourceName, "triggers.version", "2"),
+					resource.TestCheckResourceAttr(runResourceName, "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAssistantRunResourceConfig(rName string, prompt string, version string) string {
+	return fmt.Sprintf(`
+resource openai_assistant test {
+	name = %[1]q
+	model = "gpt-4o-mini"
+	instructions = "You are a terse test assistant."
+}
+
+resource openai_assistant_run test {
+	assistant_id = openai_assistant.test.id
+	prompt = %[2]q
+	expect_regex = "(?i)pong"
+	triggers = {
+		version = %[3]q
+	}
+}
+`, rName, prompt, version)
+}
+
+// newAssistantRunTestClient fakes the run and message endpoints.
+// The run is reported as queued when created and as status when retrieved.
+func newAssistantRunTestClient(t *testing.T, status string, output string) *OpenAIClient {
+	return newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v1/threads/runs":
+			w.Write([]byte(`{"id": "run_123", "object": "thread.run", "thread_id": "thread_123", "assistant_id": "asst_123", "status": "queued", "model": "gpt-4o"}`))
+		case r.Method == "GET" && r.URL.Path == "/v1/threads/thread_123/runs/run_123":
+			fmt.Fprintf(w, `{"id": "run_123", "object": "thread.run", "thread_id": "thread_123", "assistant_id": "asst_123", "status": %q, "model": "gpt-4o", "completed_at": 1700000010, "last_error": {"code": "server_error", "message": "boom"}, "usage": {"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15}}`, status)
+		case r.Method == "POST" && r.URL.Path == "/v1/threads/thread_123/runs/run_123/cancel":
+			w.Write([]byte(`{"id": "run_123", "object": "thread.run", "thread_id": "thread_123", "status": "cancelling"}`))
+		case r.Method == "GET" && r.URL.Path == "/v1/threads/thread_123/messages":
+			assert.Equal(t, "run_123", r.URL.Query().Get("run_id"))
+			fmt.Fprintf(w, `{"object": "list", "data": [{"id": "msg_123", "object": "thread.message", "role": "assistant", "content": [{"type": "text", "text": {"value": %q}}]}]}`, output)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func newTestAssistantRunModel() OpenAIAssistantRunResourceModel {
+	return OpenAIAssistantRunResourceModel{
+		Id:               types.StringUnknown(),
+		AssistantId:      types.StringValue("asst_123"),
+		Prompt:           types.StringValue("Reply in JSON."),
+		Triggers:         types.MapNull(types.StringType),
+		ExpectRegex:      types.StringNull(),
+		ExpectJSONSchema: NewJSONStringNull(),
+		ThreadId:         types.StringUnknown(),
+		Status:           types.StringUnknown(),
+		Model:            types.StringUnknown(),
+		Output:           types.StringUnknown(),
+		Usage:            types.ObjectUnknown(OpenAIAssistantRunUsageModel{}.AttrTypes()),
+		CreatedAt:        types.Int64Unknown(),
+		CompletedAt:      types.Int64Unknown(),
+	}
+}
+
+func TestAssistantRunResource_Create(t *testing.T) {
+	ctx := context.Background()
+	r := &AssistantRunResource{OpenAIResource: &OpenAIResource{client: newAssistantRunTestClient(t, "completed", `{"answer": 42}`)}}
+	s := resourceSchema(ctx, r)
+
+	plan := newTestAssistantRunModel()
+	plan.ExpectRegex = types.StringValue("answer")
+	plan.ExpectJSONSchema = NewJSONStringValue(`{"type": "object", "required": ["answer"]}`)
+
+	resp := fwresource.CreateResponse{State: newTestState(t, ctx, s, nil)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, ctx, s, &plan)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state OpenAIAssistantRunResourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "run_123", state.Id.ValueString())
+	assert.Equal(t, "thread_123", state.ThreadId.ValueString())
+	assert.Equal(t, "completed", state.Status.ValueString())
+	assert.Equal(t, `{"answer": 42}`, state.Output.ValueString())
+	assert.Equal(t, int64(15), state.Usage.Attributes()["total_tokens"].(types.Int64).ValueInt64())
+}
+
+func TestAssistantRunResource_CreateAssertionFailure(t *testing.T) {
+	ctx := context.Background()
+	r := &AssistantRunResource{OpenAIResource: &OpenAIResource{client: newAssistantRunTestClient(t, "completed", "no json here")}}
+	s := resourceSchema(ctx, r)
+
+	plan := newTestAssistantRunModel()
+	plan.ExpectJSONSchema = NewJSONStringValue(`{"type": "object"}`)
+
+	resp := fwresource.CreateResponse{State: newTestState(t, ctx, s, nil)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, ctx, s, &plan)}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Assistant Run Assertion Failed", resp.Diagnostics.Errors()[0].Summary())
+
+	// The run is still saved so that it is tainted and its thread deleted.
+	var state OpenAIAssistantRunResourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "no json here", state.Output.ValueString())
+}
+
+func TestAssistantRunResource_UpdateAssertionFailure(t *testing.T) {
+	ctx := context.Background()
+	r := &AssistantRunResource{OpenAIResource: &OpenAIResource{client: newAssistantRunTestClient(t, "completed", "")}}
+	s := resourceSchema(ctx, r)
+
+	prior := newTestAssistantRunModel()
+	prior.Id = types.StringValue("run_123")
+	prior.ThreadId = types.StringValue("thread_123")
+	prior.Status = types.StringValue("completed")
+	prior.Model = types.StringValue("gpt-4o")
+	prior.Output = types.StringValue("no json here")
+	prior.Usage = types.ObjectNull(OpenAIAssistantRunUsageModel{}.AttrTypes())
+	prior.CreatedAt = types.Int64Value(1700000000)
+	prior.CompletedAt = types.Int64Value(1700000010)
+
+	plan := prior
+	plan.ExpectJSONSchema = NewJSONStringValue(`{"type": "object"}`)
+
+	resp := fwresource.UpdateResponse{State: newTestState(t, ctx, s, &prior)}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: newTestPlan(t, ctx, s, &plan), State: newTestState(t, ctx, s, &prior)}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Assistant Run Assertion Failed", resp.Diagnostics.Errors()[0].Summary())
+
+	// The failed assertion is not saved, so that the next plan checks it again.
+	var state OpenAIAssistantRunResourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.True(t, state.ExpectJSONSchema.IsNull())
+}
+
+func TestAssistantRunResource_CreateRunFailure(t *testing.T) {
+	for _, status := range []string{"failed", "requires_action"} {
+		t.Run(status, func(t *testing.T) {
+			ctx := context.Background()
+			r := &AssistantRunResource{OpenAIResource: &OpenAIResource{client: newAssistantRunTestClient(t, status, "")}}
+			s := resourceSchema(ctx, r)
+
+			plan := newTestAssistantRunModel()
+			resp := fwresource.CreateResponse{State: newTestState(t, ctx, s, nil)}
+			r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, ctx, s, &plan)}, &resp)
+			assert.True(t, resp.Diagnostics.HasError())
+
+			var state OpenAIAssistantRunResourceModel
+			assert.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.Equal(t, "thread_123", state.ThreadId.ValueString())
+			assert.Equal(t, status, state.Status.ValueString())
+		})
+	}
+}
+
+func TestAssistantRunResource_Lifecycle(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("assistants", fakeObject{"id": "asst_abc", "object": "assistant", "model": "gpt-4o", "instructions": "Be terse.", "tools": []interface{}{}, "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_assistant_run")
+
+	config := map[string]interface{}{
+		"assistant_id": "asst_abc",
+		"prompt":       "ping",
+		"expect_regex": "ping",
+	}
+	l.apply(config)
+	threadId := l.attr("thread_id")
+	assert.Equal(t, "completed", l.attr("status"))
+	assert.Equal(t, "You said: ping", l.attr("output"))
+	l.assertNoChanges(config)
+
+	l.destroy()
+	assert.Nil(t, fake.get("threads", threadId))
+}
+
+func TestAssistantRunResource_LifecyclePollFailure(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("assistants", fakeObject{"id": "asst_abc", "object": "assistant", "model": "gpt-4o", "instructions": "Be terse.", "tools": []interface{}{}, "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_assistant_run")
+
+	fake.fail("GET", "/v1/threads/*/runs/*", 1)
+	diags := l.applyWithErrors(map[string]interface{}{
+		"assistant_id": "asst_abc",
+		"prompt":       "ping",
+	})
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, tfprotov6.DiagnosticSeverityError, diags[0].Severity)
+	}
+
+	// The run is kept in state, so that destroy deletes its thread.
+	threadId := l.attr("thread_id")
+	assert.NotEmpty(t, l.attr("id"))
+	assert.NotEmpty(t, threadId)
+	l.destroy()
+	assert.Nil(t, fake.get("threads", threadId))
+}
+
+func TestCheckAssistantRunOutput(t *testing.T) {
+	schema := NewJSONStringValue(`{"type": "object", "properties": {"answer": {"type": "integer"}}, "required": ["answer"]}`)
+	for _, c := range []struct {
+		name       string
+		output     string
+		regex      types.String
+		jsonSchema JSONStringValue
+		wantError  bool
+	}{
+		{"no assertions", "anything", types.StringNull(), NewJSONStringNull(), false},
+		{"regex match", "The answer is 42", types.StringValue(`answer is \d+`), NewJSONStringNull(), false},
+		{"regex mismatch", "I don't know", types.StringValue(`answer is \d+`), NewJSONStringNull(), true},
+		{"schema match", `{"answer": 42}`, types.StringNull(), schema, false},
+		{"schema mismatch", `{"answer": "42"}`, types.StringNull(), schema, true},
+		{"not json", "42", types.StringNull(), schema, true},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			diags := checkAssistantRunOutput(c.output, c.regex, c.jsonSchema)
+			assert.Equal(t, c.wantError, diags.HasError(), diags)
+		})
+	}
+}
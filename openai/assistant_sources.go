@@ -0,0 +1,167 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	maxAssistantInstructionsLength = 32768
+	maxAssistantDescriptionLength  = 512
+)
+
+// renderAssistantSourceFile reads a file resolved via GetFilePath. When vars
+// is not nil the content is rendered as a text/template with vars as its data.
+func renderAssistantSourceFile(filePath string, vars map[string]string) (string, error) {
+	absFilePath, err := GetFilePath(filePath)
+	if err != nil {
+		return "", err
+	}
+	b, err := os.ReadFile(*absFilePath)
+	if err != nil {
+		return "", err
+	}
+	if vars == nil {
+		return string(b), nil
+	}
+
+	tmpl, err := template.New(filePath).Option("missingkey=error").Parse(string(b))
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, vars); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// resolveAssistantSources renders instructions_file and every function
+// parameters_file into the instructions and parameters attributes, and sets
+// source_hash over the rendered content. Values stay unknown while any of the
+// inputs are unknown. When prior is set, parameters semantically equal to the
+// prior value keep the prior formatting so they do not show as a diff.
+func resolveAssistantSources(ctx context.Context, data *OpenAIAssistantSourcedResourceModel, prior *OpenAIAssistantSourcedResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.InstructionsFile.IsUnknown() || data.TemplateVars.IsUnknown() || data.Tools.IsUnknown() {
+		data.SourceHash = types.StringUnknown()
+		return diags
+	}
+
+	var vars map[string]string
+	if !data.TemplateVars.IsNull() {
+		diags.Append(data.TemplateVars.ElementsAs(ctx, &vars, false)...)
+	}
+
+	var tools, priorTools []OpenAIAssistantToolModel
+	diags.Append(data.Tools.ElementsAs(ctx, &tools, false)...)
+	if prior != nil && !prior.Tools.IsNull() && !prior.Tools.IsUnknown() {
+		diags.Append(prior.Tools.ElementsAs(ctx, &priorTools, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, t := range tools {
+		if t.Function != nil && t.Function.ParametersFile.IsUnknown() {
+			data.SourceHash = types.StringUnknown()
+			return diags
+		}
+	}
+
+	hash := sha256.New()
+	sourced := false
+
+	if !data.InstructionsFile.IsNull() {
+		instructions, err := renderAssistantSourceFile(data.InstructionsFile.ValueString(), vars)
+		if err != nil {
+			diags.AddAttributeError(path.Root("instructions_file"), "Unable to Load Instructions", fmt.Sprintf("Unable to load instructions_file, got error: %s", err))
+			return diags
+		}
+		if utf8.RuneCountInString(instructions) > maxAssistantInstructionsLength {
+			diags.AddAttributeError(path.Root("instructions_file"), "Instructions Too Long", fmt.Sprintf("The rendered instructions are %d characters long. The maximum length is %d characters.", utf8.RuneCountInString(instructions), maxAssistantInstructionsLength))
+			return diags
+		}
+		data.Instructions = types.StringValue(instructions)
+		hash.Write([]byte(instructions))
+		sourced = true
+	}
+
+	for i, t := range tools {
+		if t.Function == nil || t.Function.ParametersFile.IsNull() {
+			continue
+		}
+		attrPath := path.Root("tools").AtListIndex(i).AtName("function").AtName("parameters_file")
+		parameters, err := renderAssistantSourceFile(t.Function.ParametersFile.ValueString(), vars)
+		if err != nil {
+			diags.AddAttributeError(attrPath, "Unable to Load Function Parameters", fmt.Sprintf("Unable to load parameters_file, got error: %s", err))
+			return diags
+		}
+		if !json.Valid([]byte(parameters)) {
+			diags.AddAttributeError(attrPath, "Invalid Function Parameters", "The rendered parameters_file is not valid JSON.")
+			return diags
+		}
+
+		value := NewJSONStringValue(parameters)
+		if i < len(priorTools) && priorTools[i].Function != nil {
+			equal, d := priorTools[i].Function.Parameters.StringSemanticEquals(ctx, value)
+			diags.Append(d...)
+			if equal {
+				value = priorTools[i].Function.Parameters
+			}
+		}
+		t.Function.Parameters = value
+		hash.Write([]byte(parameters))
+		sourced = true
+	}
+
+	if !sourced {
+		data.SourceHash = types.StringNull()
+		return diags
+	}
+
+	data.Tools, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: OpenAIAssistantToolModel{}.AttrTypes()}, tools)
+	data.SourceHash = types.StringValue(hex.EncodeToString(hash.Sum(nil)))
+	return diags
+}
+
+// copyAssistantSources copies the configuration-only attributes from one
+// model to another, since the API does not return them.
+func copyAssistantSources(ctx context.Context, to *OpenAIAssistantSourcedResourceModel, from *OpenAIAssistantSourcedResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	to.InstructionsFile = from.InstructionsFile
+	to.TemplateVars = from.TemplateVars
+	to.SourceHash = from.SourceHash
+
+	if to.Tools.IsNull() || from.Tools.IsNull() || from.Tools.IsUnknown() {
+		return diags
+	}
+
+	var toTools, fromTools []OpenAIAssistantToolModel
+	diags.Append(to.Tools.ElementsAs(ctx, &toTools, false)...)
+	diags.Append(from.Tools.ElementsAs(ctx, &fromTools, false)...)
+	if diags.HasError() || len(toTools) != len(fromTools) {
+		return diags
+	}
+
+	for i := range toTools {
+		if toTools[i].Function != nil && fromTools[i].Function != nil {
+			toTools[i].Function.ParametersFile = fromTools[i].Function.ParametersFile
+		}
+	}
+	to.Tools, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: OpenAIAssistantToolModel{}.AttrTypes()}, toTools)
+	return diags
+}
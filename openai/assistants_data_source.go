@@ -0,0 +1,123 @@
+package openai
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AssistantsDataSource{}
+
+func NewAssistantsDataSource() datasource.DataSource {
+	return &AssistantsDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// AssistantsDataSource defines the data source implementation.
+type AssistantsDataSource struct {
+	*OpenAIDatasource
+}
+
+// AssistantsModel describes the data source data model.
+type AssistantsModel struct {
+	Id         types.String                   `tfsdk:"id"`
+	Order      types.String                   `tfsdk:"order"`
+	Model      types.String                   `tfsdk:"model"`
+	Metadata   map[string]string              `tfsdk:"metadata"`
+	Assistants []OpenAIAssistantResourceModel `tfsdk:"assistants"`
+}
+
+func (d *AssistantsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assistants"
+}
+
+func (d *AssistantsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Assistants data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Assistants identifier",
+				Computed:            true,
+			},
+			"order": schema.StringAttribute{
+				MarkdownDescription: "Sort order by the created_at timestamp of the assistants. asc for ascending order and desc for descending order. Defaults to desc.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("asc", "desc"),
+				},
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Only return assistants using this model.",
+				Optional:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Only return assistants whose metadata contains all of these key-value pairs.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"assistants": schema.ListNestedAttribute{
+				MarkdownDescription: "Assistants",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: openAIAssistantAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *AssistantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssistantsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assistants, err := listAllAssistants(d.client, data.Order.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Assistants", err))
+		return
+	}
+
+	data.Assistants = []OpenAIAssistantResourceModel{}
+	for _, v := range assistants {
+		if !matchAssistant(&v, data.Model.ValueString(), data.Metadata) {
+			continue
+		}
+		assistant, diags := NewOpenAIAssistantResourceModel(ctx, &v)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Assistants = append(data.Assistants, assistant)
+	}
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matchAssistant reports whether the assistant uses the model, if one is given,
+// and has every one of the metadata key-value pairs.
+func matchAssistant(assistant *Assistant, model string, metadata map[string]string) bool {
+	if model != "" && assistant.Model != model {
+		return false
+	}
+	for k, v := range metadata {
+		if value, ok := assistant.MetaData[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
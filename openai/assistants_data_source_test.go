@@ -0,0 +1,64 @@
+package openai
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAssistantsDataSource(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAssistantsDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openai_assistants.test", "id"),
+					resource.TestCheckResourceAttr("data.openai_assistants.test", "assistants.#", "1"),
+					resource.TestCheckResourceAttrPair("data.openai_assistants.test", "assistants.0.id", "openai_assistant.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssistantsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "openai_assistant" "test" {
+	name  = %[1]q
+	model = "gpt-4"
+	metadata = {
+		test_run = %[1]q
+	}
+}
+
+data "openai_assistants" "test" {
+	order = "asc"
+	model = "gpt-4"
+	metadata = {
+		test_run = %[1]q
+	}
+
+	depends_on = [openai_assistant.test]
+}
+`, rName)
+}
+
+func TestMatchAssistant(t *testing.T) {
+	assistant := &Assistant{
+		Model:    "gpt-4o",
+		MetaData: map[string]string{"team": "platform", "env": "prod"},
+	}
+
+	assert.True(t, matchAssistant(assistant, "", nil))
+	assert.True(t, matchAssistant(assistant, "gpt-4o", map[string]string{"team": "platform"}))
+	assert.False(t, matchAssistant(assistant, "gpt-4", nil))
+	assert.False(t, matchAssistant(assistant, "", map[string]string{"team": "data"}))
+	assert.False(t, matchAssistant(assistant, "", map[string]string{"owner": "platform"}))
+}
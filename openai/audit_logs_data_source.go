@@ -0,0 +1,262 @@
+package openai
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditLogsDataSource{}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// AuditLogsDataSource defines the data source implementation.
+type AuditLogsDataSource struct {
+	*OpenAIDatasource
+}
+
+// AuditLogsDataSourceModel describes the data source data model.
+type AuditLogsDataSourceModel struct {
+	Id          types.String               `tfsdk:"id"`
+	EventTypes  types.List                 `tfsdk:"event_types"`
+	ActorIds    types.List                 `tfsdk:"actor_ids"`
+	ActorEmails types.List                 `tfsdk:"actor_emails"`
+	ResourceIds types.List                 `tfsdk:"resource_ids"`
+	ProjectIds  types.List                 `tfsdk:"project_ids"`
+	EffectiveAt *AuditLogsEffectiveAtModel `tfsdk:"effective_at"`
+	AuditLogs   []AuditLogModel            `tfsdk:"audit_logs"`
+}
+
+type AuditLogsEffectiveAtModel struct {
+	Gt  timetypes.RFC3339 `tfsdk:"gt"`
+	Gte timetypes.RFC3339 `tfsdk:"gte"`
+	Lt  timetypes.RFC3339 `tfsdk:"lt"`
+	Lte timetypes.RFC3339 `tfsdk:"lte"`
+}
+
+type AuditLogModel struct {
+	Id                    types.String      `tfsdk:"id"`
+	Type                  types.String      `tfsdk:"type"`
+	EffectiveAt           timetypes.RFC3339 `tfsdk:"effective_at"`
+	ProjectId             types.String      `tfsdk:"project_id"`
+	ProjectName           types.String      `tfsdk:"project_name"`
+	ResourceId            types.String      `tfsdk:"resource_id"`
+	ActorType             types.String      `tfsdk:"actor_type"`
+	ActorUserId           types.String      `tfsdk:"actor_user_id"`
+	ActorUserEmail        types.String      `tfsdk:"actor_user_email"`
+	ActorIpAddress        types.String      `tfsdk:"actor_ip_address"`
+	ActorApiKeyId         types.String      `tfsdk:"actor_api_key_id"`
+	ActorApiKeyType       types.String      `tfsdk:"actor_api_key_type"`
+	ActorServiceAccountId types.String      `tfsdk:"actor_service_account_id"`
+	Details               JSONStringValue   `tfsdk:"details"`
+}
+
+func NewAuditLogModel(log *AuditLog) AuditLogModel {
+	model := AuditLogModel{
+		Id:                    types.StringValue(log.Id),
+		Type:                  types.StringValue(log.Type),
+		EffectiveAt:           timetypes.NewRFC3339TimeValue(time.Unix(log.EffectiveAt, 0).UTC()),
+		ProjectId:             types.StringNull(),
+		ProjectName:           types.StringNull(),
+		ResourceId:            types.StringPointerValue(log.ResourceId()),
+		ActorType:             types.StringNull(),
+		ActorUserId:           types.StringNull(),
+		ActorUserEmail:        types.StringNull(),
+		ActorIpAddress:        types.StringNull(),
+		ActorApiKeyId:         types.StringNull(),
+		ActorApiKeyType:       types.StringNull(),
+		ActorServiceAccountId: types.StringNull(),
+		Details:               NewJSONStringNull(),
+	}
+	if len(log.Details) > 0 {
+		model.Details = NewJSONStringValue(string(log.Details))
+	}
+	if log.Project != nil {
+		model.ProjectId = types.StringValue(log.Project.Id)
+		model.ProjectName = types.StringValue(log.Project.Name)
+	}
+	if log.Actor == nil {
+		return model
+	}
+	model.ActorType = types.StringValue(log.Actor.Type)
+	var user *AuditLogActorUser
+	if s := log.Actor.Session; s != nil {
+		user = s.User
+		model.ActorIpAddress = types.StringValue(s.IpAddress)
+	}
+	if k := log.Actor.ApiKey; k != nil {
+		user = k.User
+		model.ActorApiKeyId = types.StringValue(k.Id)
+		model.ActorApiKeyType = types.StringValue(k.Type)
+		if k.ServiceAccount != nil {
+			model.ActorServiceAccountId = types.StringValue(k.ServiceAccount.Id)
+		}
+	}
+	if user != nil {
+		model.ActorUserId = types.StringValue(user.Id)
+		model.ActorUserEmail = types.StringValue(user.Email)
+	}
+	return model
+}
+
+func (d *AuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *AuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	effectiveAtAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			CustomType:          timetypes.RFC3339Type{},
+			Optional:            true,
+		}
+	}
+	filterAttribute := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			MarkdownDescription: description,
+			ElementType:         types.StringType,
+			Optional:            true,
+		}
+	}
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Audit logs data source. Returns the user actions and configuration changes within the organization, across all pages. " +
+			"Use it in a `check` block to detect changes made outside of Terraform. Requires an admin key and audit logging to be enabled for the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Audit logs identifier",
+				Computed:            true,
+			},
+			"event_types":  filterAttribute("Return only events with a type in this list, e.g. `service_account.created`."),
+			"actor_ids":    filterAttribute("Return only events performed by these actors. Can be a user ID, a service account ID, or an api key tracking ID."),
+			"actor_emails": filterAttribute("Return only events performed by users with these emails."),
+			"resource_ids": filterAttribute("Return only events performed on these targets. For example, a project ID updated."),
+			"project_ids":  filterAttribute("Return only events for these projects."),
+			"effective_at": schema.SingleNestedAttribute{
+				MarkdownDescription: "Return only events whose effective_at time is in this range.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"gt":  effectiveAtAttribute("Return only events after this time (RFC3339)."),
+					"gte": effectiveAtAttribute("Return only events at or after this time (RFC3339)."),
+					"lt":  effectiveAtAttribute("Return only events before this time (RFC3339)."),
+					"lte": effectiveAtAttribute("Return only events at or before this time (RFC3339)."),
+				},
+			},
+			"audit_logs": schema.ListNestedAttribute{
+				MarkdownDescription: "The audit logs, most recent first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":   computedString("The ID of this log."),
+						"type": computedString("The event type, e.g. `project.created` or `service_account.created`."),
+						"effective_at": schema.StringAttribute{
+							MarkdownDescription: "The time (RFC3339) of the event.",
+							CustomType:          timetypes.RFC3339Type{},
+							Computed:            true,
+						},
+						"project_id":               computedString("The ID of the project the action was scoped to. Null for actions not scoped to projects."),
+						"project_name":             computedString("The name of the project the action was scoped to."),
+						"resource_id":              computedString("The ID of the object the event acted on, e.g. the service account ID of a `service_account.created` event."),
+						"actor_type":               computedString("The type of actor: `session` or `api_key`."),
+						"actor_user_id":            computedString("The ID of the user who performed the action, directly or with a user API key."),
+						"actor_user_email":         computedString("The email of the user who performed the action."),
+						"actor_ip_address":         computedString("The IP address the action was performed from, for session actors."),
+						"actor_api_key_id":         computedString("The tracking ID of the API key that performed the action."),
+						"actor_api_key_type":       computedString("The type of API key that performed the action: `user` or `service_account`."),
+						"actor_service_account_id": computedString("The ID of the service account whose API key performed the action."),
+						"details": schema.StringAttribute{
+							MarkdownDescription: "The event specific details, as JSON.",
+							CustomType:          JSONStringType{},
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aReq, diags := newListAuditLogsRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AuditLogs = []AuditLogModel{}
+	for {
+		tflog.Debug(ctx, "Reading audit logs page")
+		page, err := d.client.WithContext(ctx).AuditLogs().ListAuditLogs(aReq)
+		if err != nil {
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Audit Logs", err))
+			return
+		}
+		for i := range page.Data {
+			data.AuditLogs = append(data.AuditLogs, NewAuditLogModel(&page.Data[i]))
+		}
+		if !page.HasMore || page.LastID == "" {
+			break
+		}
+		aReq.After = &page.LastID
+	}
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newListAuditLogsRequest(ctx context.Context, data *AuditLogsDataSourceModel) (*ListAuditLogsRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limit := 100
+	aReq := &ListAuditLogsRequest{Limit: &limit}
+	diags.Append(data.EventTypes.ElementsAs(ctx, &aReq.EventTypes, false)...)
+	diags.Append(data.ActorIds.ElementsAs(ctx, &aReq.ActorIds, false)...)
+	diags.Append(data.ActorEmails.ElementsAs(ctx, &aReq.ActorEmails, false)...)
+	diags.Append(data.ResourceIds.ElementsAs(ctx, &aReq.ResourceIds, false)...)
+	diags.Append(data.ProjectIds.ElementsAs(ctx, &aReq.ProjectIds, false)...)
+	if data.EffectiveAt == nil {
+		return aReq, diags
+	}
+
+	unix := func(v timetypes.RFC3339) *int64 {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		t, d := v.ValueRFC3339Time()
+		diags.Append(d...)
+		u := t.Unix()
+		return &u
+	}
+	aReq.EffectiveAtGt = unix(data.EffectiveAt.Gt)
+	aReq.EffectiveAtGte = unix(data.EffectiveAt.Gte)
+	aReq.EffectiveAtLt = unix(data.EffectiveAt.Lt)
+	aReq.EffectiveAtLte = unix(data.EffectiveAt.Lte)
+	return aReq, diags
+}
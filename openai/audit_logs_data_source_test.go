@@ -0,0 +1,138 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAuditLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAuditLogsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openai_audit_logs.test", "id"),
+					resource.TestCheckResourceAttr("data.openai_audit_logs.test", "audit_logs.0.type", "project.created"),
+					resource.TestCheckResourceAttrPair("data.openai_audit_logs.test", "audit_logs.0.resource_id", "openai_project.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAuditLogsDataSourceConfig = `
+resource "openai_project" "test" {
+	name = "tf-acc-audit-logs"
+}
+
+data "openai_audit_logs" "test" {
+	event_types  = ["project.created"]
+	resource_ids = [openai_project.test.id]
+}
+`
+
+func TestAuditLogsDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	var queries []url.Values
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/organization/audit_logs", r.URL.Path)
+		assert.Equal(t, "Bearer test-admin-key", r.Header.Get("Authorization"))
+		queries = append(queries, r.URL.Query())
+		switch r.URL.Query().Get("after") {
+		case "":
+			w.Write([]byte(`{
+				"object": "list",
+				"data": [{
+					"id": "audit_log-1",
+					"type": "service_account.created",
+					"effective_at": 1735689600,
+					"project": {"id": "proj_abc", "name": "analytics"},
+					"actor": {"type": "session", "session": {"user": {"id": "user-1", "email": "ops@example.com"}, "ip_address": "127.0.0.1"}},
+					"service_account.created": {"id": "user-sa", "data": {"role": "member"}}
+				}],
+				"first_id": "audit_log-1",
+				"last_id": "audit_log-1",
+				"has_more": true
+			}`))
+		case "audit_log-1":
+			w.Write([]byte(`{
+				"object": "list",
+				"data": [{
+					"id": "audit_log-2",
+					"type": "project.updated",
+					"effective_at": 1735693200,
+					"project": {"id": "proj_abc", "name": "analytics"},
+					"actor": {"type": "api_key", "api_key": {"id": "key_1", "type": "service_account", "service_account": {"id": "svc_acct_1"}}},
+					"project.updated": {"id": "proj_abc", "changes_requested": {"title": "analytics"}}
+				}],
+				"first_id": "audit_log-2",
+				"last_id": "audit_log-2",
+				"has_more": false
+			}`))
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("after"))
+		}
+	})
+	d := &AuditLogsDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	config := AuditLogsDataSourceModel{
+		Id:          types.StringNull(),
+		EventTypes:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("service_account.created"), types.StringValue("project.updated")}),
+		ActorIds:    types.ListNull(types.StringType),
+		ActorEmails: types.ListNull(types.StringType),
+		ResourceIds: types.ListNull(types.StringType),
+		ProjectIds:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("proj_abc")}),
+		EffectiveAt: &AuditLogsEffectiveAtModel{
+			Gt:  timetypes.NewRFC3339Null(),
+			Gte: timetypes.NewRFC3339ValueMust("2025-01-01T00:00:00Z"),
+			Lt:  timetypes.NewRFC3339Null(),
+			Lte: timetypes.NewRFC3339Null(),
+		},
+	}
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	if assert.Len(t, queries, 2) {
+		assert.Equal(t, []string{"service_account.created", "project.updated"}, queries[0]["event_types[]"])
+		assert.Equal(t, []string{"proj_abc"}, queries[0]["project_ids[]"])
+		assert.Equal(t, "1735689600", queries[0].Get("effective_at[gte]"))
+		assert.Empty(t, queries[0].Get("effective_at[lt]"))
+		assert.Equal(t, "100", queries[0].Get("limit"))
+	}
+
+	var state AuditLogsDataSourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	if assert.Len(t, state.AuditLogs, 2) {
+		created := state.AuditLogs[0]
+		assert.Equal(t, "service_account.created", created.Type.ValueString())
+		assert.Equal(t, "2025-01-01T00:00:00Z", created.EffectiveAt.ValueString())
+		assert.Equal(t, "proj_abc", created.ProjectId.ValueString())
+		assert.Equal(t, "user-sa", created.ResourceId.ValueString())
+		assert.Equal(t, "session", created.ActorType.ValueString())
+		assert.Equal(t, "ops@example.com", created.ActorUserEmail.ValueString())
+		assert.Equal(t, "127.0.0.1", created.ActorIpAddress.ValueString())
+		assert.True(t, created.ActorApiKeyId.IsNull())
+		assert.JSONEq(t, `{"id": "user-sa", "data": {"role": "member"}}`, created.Details.ValueString())
+
+		updated := state.AuditLogs[1]
+		assert.Equal(t, "proj_abc", updated.ResourceId.ValueString())
+		assert.Equal(t, "key_1", updated.ActorApiKeyId.ValueString())
+		assert.Equal(t, "service_account", updated.ActorApiKeyType.ValueString())
+		assert.Equal(t, "svc_acct_1", updated.ActorServiceAccountId.ValueString())
+		assert.True(t, updated.ActorUserId.IsNull())
+	}
+}
@@ -0,0 +1,159 @@
+package openai
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BatchDataSource{}
+
+func NewBatchDataSource() datasource.DataSource {
+	return &BatchDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// BatchDataSource defines the data source implementation.
+type BatchDataSource struct {
+	*OpenAIDatasource
+}
+
+func (d *BatchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_batch"
+}
+
+func (d *BatchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Batch data source",
+
+		Attributes: openAIBatchAttributes(),
+	}
+}
+
+func (d *BatchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OpenAIBatchModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	batch, err := d.client.WithContext(ctx).Batches().RetrieveBatch(data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Batch", err))
+		return
+	}
+
+	data, diags = NewOpenAIBatchModel(ctx, batch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func openAIBatchAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The identifier, which can be referenced in API endpoints.",
+			Required:            true,
+		},
+		"object": schema.StringAttribute{
+			MarkdownDescription: "The object type, which is always batch.",
+			Computed:            true,
+		},
+		"endpoint": schema.StringAttribute{
+			MarkdownDescription: "The OpenAI API endpoint used by the batch.",
+			Computed:            true,
+		},
+		"input_file_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the input file for the batch.",
+			Computed:            true,
+		},
+		"completion_window": schema.StringAttribute{
+			MarkdownDescription: "The time frame within which the batch should be processed.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The current status of the batch: validating, failed, in_progress, finalizing, completed, expired, cancelling or cancelled.",
+			Computed:            true,
+		},
+		"output_file_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the file containing the outputs of successfully executed requests.",
+			Computed:            true,
+		},
+		"error_file_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the file containing the outputs of requests with errors.",
+			Computed:            true,
+		},
+		"created_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch was created.",
+			Computed:            true,
+		},
+		"in_progress_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch started processing.",
+			Computed:            true,
+		},
+		"expires_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch will expire.",
+			Computed:            true,
+		},
+		"finalizing_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch started finalizing.",
+			Computed:            true,
+		},
+		"completed_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch was completed.",
+			Computed:            true,
+		},
+		"failed_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch failed.",
+			Computed:            true,
+		},
+		"expired_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch expired.",
+			Computed:            true,
+		},
+		"cancelling_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch started cancelling.",
+			Computed:            true,
+		},
+		"cancelled_at": schema.Int64Attribute{
+			MarkdownDescription: "The Unix timestamp (in seconds) for when the batch was cancelled.",
+			Computed:            true,
+		},
+		"request_counts": schema.SingleNestedAttribute{
+			MarkdownDescription: "The request counts for different statuses within the batch.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"total": schema.Int64Attribute{
+					MarkdownDescription: "Total number of requests in the batch.",
+					Computed:            true,
+				},
+				"completed": schema.Int64Attribute{
+					MarkdownDescription: "Number of requests that have been completed successfully.",
+					Computed:            true,
+				},
+				"failed": schema.Int64Attribute{
+					MarkdownDescription: "Number of requests that have failed.",
+					Computed:            true,
+				},
+			},
+		},
+		"metadata": schema.MapAttribute{
+			MarkdownDescription: "Set of 16 key-value pairs attached to the batch.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package openai
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBatchDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccOpenAI(t); testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBatchDataSourceConfig("./test-fixtures/batch_input.jsonl"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.openai_batch.test", "id", "openai_batch.test", "id"),
+					resource.TestCheckResourceAttr("data.openai_batch.test", "endpoint", "/v1/embeddings"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBatchDataSourceConfig(filename string) string {
+	return testAccBatchResourceConfig(filename) + `
+data "openai_batch" "test" {
+	id = openai_batch.test.id
+}
+`
+}
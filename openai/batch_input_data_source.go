@@ -0,0 +1,224 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BatchInputDataSource{}
+
+func NewBatchInputDataSource() datasource.DataSource {
+	return &BatchInputDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// BatchInputDataSource defines the data source implementation.
+type BatchInputDataSource struct {
+	*OpenAIDatasource
+}
+
+// BatchInputModel describes the data source data model.
+type BatchInputModel struct {
+	Id           types.String  `tfsdk:"id"`
+	Requests     types.Dynamic `tfsdk:"requests"`
+	Endpoint     types.String  `tfsdk:"endpoint"`
+	RequestCount types.Int64   `tfsdk:"request_count"`
+	Jsonl        types.String  `tfsdk:"jsonl"`
+	Sha256       types.String  `tfsdk:"sha256"`
+}
+
+// BatchInputRequest is a single line of a batch input file.
+type BatchInputRequest struct {
+	CustomId string          `json:"custom_id"`
+	Method   string          `json:"method"`
+	Url      string          `json:"url"`
+	Body     json.RawMessage `json:"body"`
+}
+
+func (d *BatchInputDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_batch_input"
+}
+
+func (d *BatchInputDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Batch input data source. Renders a list of requests into the JSONL format expected by the Batch API, ready to be uploaded with an `openai_file` resource using `purpose = \"batch\"`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Batch input identifier. This is the sha256 of the rendered JSONL.",
+				Computed:            true,
+			},
+			"requests": schema.DynamicAttribute{
+				MarkdownDescription: "List of requests. Each request is an object with a unique `custom_id`, a `url` such as `/v1/chat/completions`, an optional `method` (defaults to `POST`) and a `body` given either as a JSON string or as an object. All requests must target the same `url`.",
+				Required:            true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The endpoint targeted by all requests. Use this as the `endpoint` of an `openai_batch` resource.",
+				Computed:            true,
+			},
+			"request_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests in the batch input.",
+				Computed:            true,
+			},
+			"jsonl": schema.StringAttribute{
+				MarkdownDescription: "The rendered JSONL, one request per line.",
+				Computed:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "Hex encoded sha256 of the rendered JSONL.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *BatchInputDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BatchInputModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := attrValueToJSON(data.Requests)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("requests"), "Invalid Batch Input", fmt.Sprintf("Unable to read requests, got error: %s", err))
+		return
+	}
+
+	requests, err := NewBatchInputRequests(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("requests"), "Invalid Batch Input", err.Error())
+		return
+	}
+
+	jsonl, err := RenderBatchInput(requests)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("requests"), "Invalid Batch Input", err.Error())
+		return
+	}
+
+	sum := sha256.Sum256([]byte(jsonl))
+	data.Id = types.StringValue(hex.EncodeToString(sum[:]))
+	data.Sha256 = data.Id
+	data.Endpoint = types.StringValue(requests[0].Url)
+	data.RequestCount = types.Int64Value(int64(len(requests)))
+	data.Jsonl = types.StringValue(jsonl)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// NewBatchInputRequests converts the decoded `requests` attribute into batch input requests.
+func NewBatchInputRequests(value interface{}) ([]BatchInputRequest, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("requests must be a list of objects")
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("requests must contain at least one request")
+	}
+
+	requests := make([]BatchInputRequest, 0, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("requests[%d] must be an object", i)
+		}
+		for k := range obj {
+			switch k {
+			case "custom_id", "method", "url", "body":
+			default:
+				return nil, fmt.Errorf("requests[%d] has unsupported attribute %q", i, k)
+			}
+		}
+
+		var r BatchInputRequest
+		for _, f := range []struct {
+			name   string
+			target *string
+		}{{"custom_id", &r.CustomId}, {"method", &r.Method}, {"url", &r.Url}} {
+			switch v := obj[f.name].(type) {
+			case nil:
+			case string:
+				*f.target = v
+			default:
+				return nil, fmt.Errorf("requests[%d].%s must be a string", i, f.name)
+			}
+		}
+		if r.Method == "" {
+			r.Method = "POST"
+		}
+
+		switch body := obj["body"].(type) {
+		case nil:
+			return nil, fmt.Errorf("requests[%d].body is required", i)
+		case string:
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, []byte(body)); err != nil {
+				return nil, fmt.Errorf("requests[%d].body is not valid JSON: %s", i, err)
+			}
+			r.Body = buf.Bytes()
+		case map[string]interface{}:
+			b, err := json.Marshal(body)
+			if err != nil {
+				return nil, fmt.Errorf("requests[%d].body could not be encoded: %s", i, err)
+			}
+			r.Body = b
+		default:
+			return nil, fmt.Errorf("requests[%d].body must be a JSON string or an object", i)
+		}
+
+		requests = append(requests, r)
+	}
+	return requests, nil
+}
+
+// RenderBatchInput validates the requests and renders them as JSONL.
+func RenderBatchInput(requests []BatchInputRequest) (string, error) {
+	var sb strings.Builder
+	customIds := map[string]int{}
+	for i, r := range requests {
+		if r.CustomId == "" {
+			return "", fmt.Errorf("requests[%d].custom_id is required", i)
+		}
+		if j, ok := customIds[r.CustomId]; ok {
+			return "", fmt.Errorf("requests[%d].custom_id %q is already used by requests[%d]", i, r.CustomId, j)
+		}
+		customIds[r.CustomId] = i
+
+		if r.Url == "" {
+			return "", fmt.Errorf("requests[%d].url is required", i)
+		}
+		if r.Url != requests[0].Url {
+			return "", fmt.Errorf("requests[%d].url %q does not match %q. All requests in a batch must target the same endpoint", i, r.Url, requests[0].Url)
+		}
+		if r.Method != "POST" {
+			return "", fmt.Errorf("requests[%d].method %q is not supported. Only POST is currently supported", i, r.Method)
+		}
+		if !bytes.HasPrefix(bytes.TrimSpace(r.Body), []byte("{")) {
+			return "", fmt.Errorf("requests[%d].body must be a JSON object", i)
+		}
+
+		line, err := json.Marshal(r)
+		if err != nil {
+			return "", err
+		}
+		sb.Write(line)
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,127 @@
+package openai
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBatchInputDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBatchInputDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openai_batch_input.test", "endpoint", "/v1/embeddings"),
+					resource.TestCheckResourceAttr("data.openai_batch_input.test", "request_count", "2"),
+					resource.TestCheckResourceAttr("data.openai_batch_input.test", "jsonl", "{\"custom_id\":\"request-1\",\"method\":\"POST\",\"url\":\"/v1/embeddings\",\"body\":{\"input\":\"Hello world\",\"model\":\"text-embedding-3-small\"}}\n{\"custom_id\":\"request-2\",\"method\":\"POST\",\"url\":\"/v1/embeddings\",\"body\":{\"model\":\"text-embedding-3-small\",\"input\":\"Goodbye world\"}}\n"),
+					resource.TestCheckResourceAttrSet("data.openai_batch_input.test", "sha256"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBatchInputDataSourceConfig = `
+data "openai_batch_input" "test" {
+	requests = [
+		{
+			custom_id = "request-1"
+			url       = "/v1/embeddings"
+			body = {
+				model = "text-embedding-3-small"
+				input = "Hello world"
+			}
+		},
+		{
+			custom_id = "request-2"
+			method    = "POST"
+			url       = "/v1/embeddings"
+			body      = "{\"model\": \"text-embedding-3-small\", \"input\": \"Goodbye world\"}"
+		},
+	]
+}
+`
+
+func TestRenderBatchInput(t *testing.T) {
+	requests, err := NewBatchInputRequests([]interface{}{
+		map[string]interface{}{
+			"custom_id": "request-1",
+			"url":       "/v1/chat/completions",
+			"body":      map[string]interface{}{"model": "gpt-4o-mini", "max_tokens": 10},
+		},
+		map[string]interface{}{
+			"custom_id": "request-2",
+			"method":    "POST",
+			"url":       "/v1/chat/completions",
+			"body":      "{\n  \"model\": \"gpt-4o-mini\"\n}",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "POST", requests[0].Method)
+
+	jsonl, err := RenderBatchInput(requests)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"custom_id":"request-1","method":"POST","url":"/v1/chat/completions","body":{"max_tokens":10,"model":"gpt-4o-mini"}}
+{"custom_id":"request-2","method":"POST","url":"/v1/chat/completions","body":{"model":"gpt-4o-mini"}}
+`, jsonl)
+}
+
+func TestRenderBatchInput_Errors(t *testing.T) {
+	body := map[string]interface{}{"model": "gpt-4o-mini"}
+	for name, tc := range map[string]struct {
+		requests []interface{}
+		err      string
+	}{
+		"empty": {
+			requests: []interface{}{},
+			err:      "at least one request",
+		},
+		"duplicate custom_id": {
+			requests: []interface{}{
+				map[string]interface{}{"custom_id": "a", "url": "/v1/embeddings", "body": body},
+				map[string]interface{}{"custom_id": "a", "url": "/v1/embeddings", "body": body},
+			},
+			err: `requests[1].custom_id "a" is already used by requests[0]`,
+		},
+		"mixed endpoints": {
+			requests: []interface{}{
+				map[string]interface{}{"custom_id": "a", "url": "/v1/embeddings", "body": body},
+				map[string]interface{}{"custom_id": "b", "url": "/v1/chat/completions", "body": body},
+			},
+			err: "must target the same endpoint",
+		},
+		"invalid json body": {
+			requests: []interface{}{
+				map[string]interface{}{"custom_id": "a", "url": "/v1/embeddings", "body": "{model"},
+			},
+			err: "requests[0].body is not valid JSON",
+		},
+		"non object body": {
+			requests: []interface{}{
+				map[string]interface{}{"custom_id": "a", "url": "/v1/embeddings", "body": "[1, 2]"},
+			},
+			err: "requests[0].body must be a JSON object",
+		},
+		"unsupported method": {
+			requests: []interface{}{
+				map[string]interface{}{"custom_id": "a", "method": "GET", "url": "/v1/embeddings", "body": body},
+			},
+			err: "Only POST is currently supported",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			requests, err := NewBatchInputRequests(tc.requests)
+			if err == nil {
+				_, err = RenderBatchInput(requests)
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.err)
+			}
+		})
+	}
+}
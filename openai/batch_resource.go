@@ -0,0 +1,356 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BatchResource{}
+var _ resource.ResourceWithImportState = &BatchResource{}
+
+// batchWaitTimeout covers the 24h completion window plus time to finalize.
+const batchWaitTimeout = 25 * time.Hour
+
+func NewBatchResource() resource.Resource {
+	return &BatchResource{OpenAIResource: &OpenAIResource{}}
+}
+
+// BatchResource defines the resource implementation.
+type BatchResource struct {
+	*OpenAIResource
+}
+
+func (r *BatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_batch"
+}
+
+func (r *BatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Batch resource. Creates a batch of API requests for asynchronous processing. The batch is cancelled on destroy if it has not finished.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier, which can be referenced in API endpoints.",
+				Computed:            true,
+			},
+			"object": schema.StringAttribute{
+				MarkdownDescription: "The object type, which is always batch.",
+				Computed:            true,
+			},
+			"input_file_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of an uploaded file that contains requests for the new batch. The file must be a JSONL file uploaded with purpose batch.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The endpoint to be used for all requests in the batch. Currently /v1/responses, /v1/chat/completions, /v1/embeddings, and /v1/completions are supported.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"completion_window": schema.StringAttribute{
+				MarkdownDescription: "The time frame within which the batch should be processed. Currently only 24h is supported.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("24h"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Set of 16 key-value pairs that can be attached to a batch. Keys can be a maximum of 64 characters long and values can be a maximum of 512 characters long.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          metadataValidators(),
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the batch to complete.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the batch: validating, failed, in_progress, finalizing, completed, expired, cancelling or cancelled.",
+				Computed:            true,
+			},
+			"output_file_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the file containing the outputs of successfully executed requests.",
+				Computed:            true,
+			},
+			"error_file_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the file containing the outputs of requests with errors.",
+				Computed:            true,
+			},
+			"created_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch was created.",
+				Computed:            true,
+			},
+			"in_progress_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch started processing.",
+				Computed:            true,
+			},
+			"expires_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch will expire.",
+				Computed:            true,
+			},
+			"finalizing_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch started finalizing.",
+				Computed:            true,
+			},
+			"completed_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch was completed.",
+				Computed:            true,
+			},
+			"failed_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch failed.",
+				Computed:            true,
+			},
+			"expired_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch expired.",
+				Computed:            true,
+			},
+			"cancelling_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch started cancelling.",
+				Computed:            true,
+			},
+			"cancelled_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the batch was cancelled.",
+				Computed:            true,
+			},
+			"request_counts": schema.SingleNestedAttribute{
+				MarkdownDescription: "The request counts for different statuses within the batch.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"total": schema.Int64Attribute{
+						MarkdownDescription: "Total number of requests in the batch.",
+						Computed:            true,
+					},
+					"completed": schema.Int64Attribute{
+						MarkdownDescription: "Number of requests that have been completed successfully.",
+						Computed:            true,
+					},
+					"failed": schema.Int64Attribute{
+						MarkdownDescription: "Number of requests that have failed.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *BatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OpenAIBatchResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating Batch...")
+
+	bReq := CreateBatchRequest{
+		InputFileId:      data.InputFileId.ValueString(),
+		Endpoint:         data.Endpoint.ValueString(),
+		CompletionWindow: data.CompletionWindow.ValueString(),
+	}
+	resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &bReq.Metadata, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	batch, err := r.client.WithContext(ctx).Batches().CreateBatch(&bReq)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create batch", err))
+		return
+	}
+	tflog.Info(ctx, "Batch created successfully")
+
+	data, diags = NewOpenAIBatchResourceModel(ctx, batch, data.Wait)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Wait.ValueBool() {
+		resp.Diagnostics.Append(r.waitForBatch(ctx, &data, &resp.State)...)
+	}
+}
+
+func (r *BatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OpenAIBatchResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading Batch with id: %s", data.Id.ValueString()))
+	batch, err := r.client.WithContext(ctx).Batches().RetrieveBatch(data.Id.ValueString())
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Batch does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve batch", err))
+		return
+	}
+
+	data, diags = NewOpenAIBatchResourceModel(ctx, batch, data.Wait)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OpenAIBatchResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	var state OpenAIBatchResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only wait can change in place
+	batch, err := r.client.WithContext(ctx).Batches().RetrieveBatch(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve batch", err))
+		return
+	}
+
+	data, diags = NewOpenAIBatchResourceModel(ctx, batch, data.Wait)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Wait.ValueBool() && !isBatchTerminal(batch.Status) {
+		resp.Diagnostics.Append(r.waitForBatch(ctx, &data, &resp.State)...)
+	}
+}
+
+func (r *BatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OpenAIBatchResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	batch, err := r.client.WithContext(ctx).Batches().RetrieveBatch(data.Id.ValueString())
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Batch does not exist")
+			return
+		}
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve batch", err))
+		return
+	}
+
+	// Batches cannot be deleted. Cancel any batch that is still running.
+	if isBatchTerminal(batch.Status) || batch.Status == "cancelling" {
+		tflog.Info(ctx, fmt.Sprintf("Batch %s is %s, nothing to cancel", batch.Id, batch.Status))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Cancelling Batch: %s", batch.Id))
+	_, err = r.client.WithContext(ctx).Batches().CancelBatch(batch.Id)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic(fmt.Sprintf("Unable to cancel batch %s", batch.Id), err))
+		return
+	}
+	tflog.Trace(ctx, "Batch cancelled successfully")
+}
+
+func (r *BatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func isBatchTerminal(status string) bool {
+	switch status {
+	case "completed", "failed", "expired", "cancelled":
+		return true
+	default:
+		return false
+	}
+}
+
+// waitForBatch polls the batch until it completes, saving every refreshed batch into state.
+func (r *BatchResource) waitForBatch(ctx context.Context, data *OpenAIBatchResourceModel, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Waiting for batch completion...")
+	batchId := data.Id.ValueString()
+	var batch *Batch
+	err := retry.RetryContext(ctx, batchWaitTimeout, func() *retry.RetryError {
+		var err error
+		batch, err = r.client.WithContext(ctx).Batches().RetrieveBatch(batchId)
+		if err != nil {
+			// The client returns an empty batch with the error, which must not overwrite the state.
+			batch = nil
+			return retry.NonRetryableError(err)
+		}
+		switch batch.Status {
+		case "completed":
+			return nil
+		case "validating", "in_progress", "finalizing":
+			tflog.Info(ctx, fmt.Sprintf("Batch State: %s... Retrying...", batch.Status))
+			return retry.RetryableError(fmt.Errorf("batch still running"))
+		default:
+			return retry.NonRetryableError(fmt.Errorf("unexpected batch status: %s", batch.Status))
+		}
+	})
+	if batch != nil {
+		var d diag.Diagnostics
+		*data, d = NewOpenAIBatchResourceModel(ctx, batch, data.Wait)
+		diags.Append(d...)
+		diags.Append(state.Set(ctx, data)...)
+	}
+	if err != nil {
+		diags.Append(NewOpenAIErrorDiagnostic(fmt.Sprintf("Unable to wait for batch %s", batchId), err))
+	}
+	return diags
+}
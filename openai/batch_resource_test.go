@@ -0,0 +1,97 @@
+package openai
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBatchResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccOpenAI(t); testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBatchResourceConfig("./test-fixtures/batch_input.jsonl"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_batch.test", "id"),
+					resource.TestCheckResourceAttr("openai_batch.test", "endpoint", "/v1/embeddings"),
+					resource.TestCheckResourceAttr("openai_batch.test", "completion_window", "24h"),
+					resource.TestCheckResourceAttr("openai_batch.test", "metadata.job", "nightly-embeddings"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "openai_batch.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait", "status", "request_counts", "in_progress_at", "finalizing_at", "completed_at", "output_file_id", "error_file_id"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBatchResourceConfig(filename string) string {
+	return fmt.Sprintf(`
+resource "openai_file" "test" {
+	filepath = %[1]q
+	purpose = "batch"
+}
+
+resource "openai_batch" "test" {
+	input_file_id = openai_file.test.id
+	endpoint = "/v1/embeddings"
+	metadata = {
+		job = "nightly-embeddings"
+	}
+}
+`, filename)
+}
+
+func TestBatchResource_Lifecycle(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("files", fakeObject{"id": "file_input", "object": "file", "bytes": 512, "filename": "requests.jsonl", "purpose": "batch", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_batch")
+
+	config := map[string]interface{}{
+		"input_file_id": "file_input",
+		"endpoint":      "/v1/chat/completions",
+		"wait":          true,
+	}
+	l.apply(config)
+	id := l.attr("id")
+	assert.Equal(t, "completed", l.attr("status"))
+	assert.NotEmpty(t, l.attr("output_file_id"))
+	l.assertNoChanges(config)
+
+	// Completed batches have nothing to cancel.
+	l.destroy()
+	assert.Equal(t, "completed", fake.get("batches", id)["status"])
+}
+
+func TestBatchResource_LifecyclePollFailure(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("files", fakeObject{"id": "file_input", "object": "file", "bytes": 512, "filename": "requests.jsonl", "purpose": "batch", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_batch")
+
+	fake.fail("GET", "/v1/batches/*", 1)
+	diags := l.applyWithErrors(map[string]interface{}{
+		"input_file_id": "file_input",
+		"endpoint":      "/v1/chat/completions",
+		"wait":          true,
+	})
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, tfprotov6.DiagnosticSeverityError, diags[0].Severity)
+	}
+
+	// The batch is kept in state, so that destroy cancels it.
+	id := l.attr("id")
+	assert.NotEmpty(t, id)
+	l.destroy()
+	assert.Equal(t, "cancelling", fake.get("batches", id)["status"])
+}
@@ -0,0 +1,92 @@
+package openai
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BatchesDataSource{}
+
+func NewBatchesDataSource() datasource.DataSource {
+	return &BatchesDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// BatchesDataSource defines the data source implementation.
+type BatchesDataSource struct {
+	*OpenAIDatasource
+}
+
+// BatchesModel describes the data source data model.
+type BatchesModel struct {
+	Id      types.String       `tfsdk:"id"`
+	Batches []OpenAIBatchModel `tfsdk:"batches"`
+}
+
+func (d *BatchesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_batches"
+}
+
+func (d *BatchesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Batches data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Batches identifier",
+				Computed:            true,
+			},
+			"batches": schema.ListNestedAttribute{
+				MarkdownDescription: "Batches",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: openAIBatchAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *BatchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BatchesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var after *string
+	for {
+		batches, err := d.client.WithContext(ctx).Batches().ListBatches(after, nil)
+		if err != nil {
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Batches", err))
+			return
+		}
+
+		for _, v := range batches.Data {
+			batch, diags := NewOpenAIBatchModel(ctx, &v)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.Batches = append(data.Batches, batch)
+		}
+
+		if !batches.HasMore || batches.LastID == "" {
+			break
+		}
+		after = &batches.LastID
+	}
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,28 @@
+package openai
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBatchesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBatchesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify ID has any value set
+					resource.TestCheckResourceAttrSet("data.openai_batches.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBatchesDataSourceConfig = `
+data "openai_batches" "test" {}
+`
@@ -0,0 +1,289 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ChatCompletionDataSource{}
+
+func NewChatCompletionDataSource() datasource.DataSource {
+	return &ChatCompletionDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// ChatCompletionDataSource defines the data source implementation.
+type ChatCompletionDataSource struct {
+	*OpenAIDatasource
+}
+
+// ChatCompletionDataSourceModel describes the data source data model.
+type ChatCompletionDataSourceModel struct {
+	Id                  types.String                        `tfsdk:"id"`
+	Model               types.String                        `tfsdk:"model"`
+	Messages            []ChatCompletionMessageModel        `tfsdk:"messages"`
+	ResponseFormat      *OpenAIAssistantResponseFormatModel `tfsdk:"response_format"`
+	Seed                types.Int64                         `tfsdk:"seed"`
+	Temperature         types.Float64                       `tfsdk:"temperature"`
+	MaxCompletionTokens types.Int64                         `tfsdk:"max_completion_tokens"`
+	Content             types.String                        `tfsdk:"content"`
+	Refusal             types.String                        `tfsdk:"refusal"`
+	Parsed              types.Dynamic                       `tfsdk:"parsed"`
+	FinishReason        types.String                        `tfsdk:"finish_reason"`
+	SystemFingerprint   types.String                        `tfsdk:"system_fingerprint"`
+	Usage               types.Object                        `tfsdk:"usage"`
+}
+
+type ChatCompletionMessageModel struct {
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
+	Name    types.String `tfsdk:"name"`
+}
+
+type ChatCompletionUsageModel struct {
+	PromptTokens     types.Int64 `tfsdk:"prompt_tokens"`
+	CompletionTokens types.Int64 `tfsdk:"completion_tokens"`
+	TotalTokens      types.Int64 `tfsdk:"total_tokens"`
+}
+
+func (e ChatCompletionUsageModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"prompt_tokens":     types.Int64Type,
+		"completion_tokens": types.Int64Type,
+		"total_tokens":      types.Int64Type,
+	}
+}
+
+func (d *ChatCompletionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chat_completion"
+}
+
+func (d *ChatCompletionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Chat Completion data source. Creates a model response for the given conversation. " +
+			"The completion is requested again on every plan, so set `seed` and a low `temperature` to keep the output as stable as possible, and use `response_format` with a JSON schema to get structured output in `parsed`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the chat completion.",
+				Computed:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "ID of the model to use.",
+				Required:            true,
+			},
+			"messages": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of messages comprising the conversation so far.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the author of this message: developer, system, user or assistant.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("developer", "system", "user", "assistant"),
+							},
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The contents of the message.",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "An optional name for the participant. Provides the model information to differentiate between participants of the same role.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"response_format": schema.SingleNestedAttribute{
+				MarkdownDescription: "An object specifying the format that the model must output.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Setting to {\"type\": \"json_schema\", \"json_schema\": {...} } enables Structured Outputs which ensures the model will match your supplied JSON schema. Setting to { \"type\": \"json_object\" } enables JSON mode, which ensures the message the model generates is valid JSON.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("text", "json_object", "json_schema"),
+						},
+					},
+					"json_schema": schema.SingleNestedAttribute{
+						MarkdownDescription: "Structured Outputs configuration options, including a JSON Schema.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"description": schema.StringAttribute{
+								MarkdownDescription: "A description of what the response format is for, used by the model to determine how to respond in the format.",
+								Optional:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the response format. Must be a-z, A-Z, 0-9, or contain underscores and dashes, with a maximum length of 64.",
+								Required:            true,
+								Validators:          functionNameValidators(),
+							},
+							"schema": schema.StringAttribute{
+								CustomType:          JSONStringType{},
+								MarkdownDescription: "The schema for the response format, described as a JSON Schema object.",
+								Required:            true,
+							},
+							"strict": schema.BoolAttribute{
+								MarkdownDescription: "Whether to enable strict schema adherence when generating the output.",
+								Optional:            true,
+							},
+						},
+					},
+				},
+			},
+			"seed": schema.Int64Attribute{
+				MarkdownDescription: "If specified, the system will make a best effort to sample deterministically, such that repeated requests with the same seed and parameters should return the same result.",
+				Optional:            true,
+			},
+			"temperature": schema.Float64Attribute{
+				MarkdownDescription: "What sampling temperature to use, between 0 and 2. Lower values make the output more focused and deterministic.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 2),
+				},
+			},
+			"max_completion_tokens": schema.Int64Attribute{
+				MarkdownDescription: "An upper bound for the number of tokens that can be generated for a completion, including visible output tokens and reasoning tokens.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The contents of the message generated by the model.",
+				Computed:            true,
+			},
+			"refusal": schema.StringAttribute{
+				MarkdownDescription: "The refusal message generated by the model, if it refused to answer.",
+				Computed:            true,
+			},
+			"parsed": schema.DynamicAttribute{
+				MarkdownDescription: "The content decoded from JSON, when response_format requests JSON output. Null otherwise.",
+				Computed:            true,
+			},
+			"finish_reason": schema.StringAttribute{
+				MarkdownDescription: "The reason the model stopped generating tokens: stop, length, content_filter or tool_calls.",
+				Computed:            true,
+			},
+			"system_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The backend configuration that the model runs with. Changes to it can affect determinism.",
+				Computed:            true,
+			},
+			"usage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Usage statistics for the completion request.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"prompt_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Number of tokens in the prompt.",
+						Computed:            true,
+					},
+					"completion_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Number of tokens in the generated completion.",
+						Computed:            true,
+					},
+					"total_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Total number of tokens used in the request (prompt + completion).",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ChatCompletionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ChatCompletionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cReq := ChatCompletionRequest{
+		Model:               data.Model.ValueString(),
+		Seed:                data.Seed.ValueInt64Pointer(),
+		Temperature:         data.Temperature.ValueFloat64Pointer(),
+		MaxCompletionTokens: data.MaxCompletionTokens.ValueInt64Pointer(),
+	}
+	for _, m := range data.Messages {
+		cReq.Messages = append(cReq.Messages, ChatCompletionMessage{
+			Role:    m.Role.ValueString(),
+			Content: m.Content.ValueString(),
+			Name:    m.Name.ValueStringPointer(),
+		})
+	}
+	if data.ResponseFormat != nil {
+		var diags diag.Diagnostics
+		cReq.ResponseFormat, diags = expandAssistantResponseFormat(data.ResponseFormat)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Creating Chat Completion...")
+	completion, err := d.client.WithContext(ctx).ChatCompletions().CreateChatCompletion(&cReq)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create chat completion", err))
+		return
+	}
+	if len(completion.Choices) == 0 {
+		resp.Diagnostics.AddError("Empty Chat Completion", "Unable to create chat completion, the response contains no choices")
+		return
+	}
+
+	choice := completion.Choices[0]
+	data.Id = types.StringValue(completion.Id)
+	data.Content = types.StringPointerValue(choice.Message.Content)
+	data.Refusal = types.StringPointerValue(choice.Message.Refusal)
+	data.FinishReason = types.StringValue(choice.FinishReason)
+	data.SystemFingerprint = types.StringPointerValue(completion.SystemFingerprint)
+	data.Parsed = types.DynamicNull()
+
+	if data.ResponseFormat != nil && data.ResponseFormat.Type.ValueString() != "text" && choice.Message.Content != nil {
+		data.Parsed, err = decodeJSONToDynamic(*choice.Message.Content)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("response_format"), "Invalid JSON Output", fmt.Sprintf("Unable to decode the completion content as JSON (finish_reason %s), got error: %s", choice.FinishReason, err))
+			return
+		}
+	}
+
+	if completion.Usage == nil {
+		data.Usage = types.ObjectNull(ChatCompletionUsageModel{}.AttrTypes())
+	} else {
+		usage := ChatCompletionUsageModel{
+			PromptTokens:     types.Int64Value(completion.Usage.PromptTokens),
+			CompletionTokens: types.Int64Value(completion.Usage.CompletionTokens),
+			TotalTokens:      types.Int64Value(completion.Usage.TotalTokens),
+		}
+		var diags diag.Diagnostics
+		data.Usage, diags = types.ObjectValueFrom(ctx, ChatCompletionUsageModel{}.AttrTypes(), usage)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
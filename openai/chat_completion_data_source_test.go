@@ -0,0 +1,148 @@
+package openai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccChatCompletionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccChatCompletionDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openai_chat_completion.test", "id"),
+					resource.TestCheckResourceAttrSet("data.openai_chat_completion.test", "content"),
+					resource.TestCheckResourceAttr("data.openai_chat_completion.test", "finish_reason", "stop"),
+					resource.TestCheckResourceAttr("data.openai_chat_completion.test", "parsed.colour", "blue"),
+					resource.TestCheckResourceAttrSet("data.openai_chat_completion.test", "usage.total_tokens"),
+				),
+			},
+		},
+	})
+}
+
+const testAccChatCompletionDataSourceConfig = `
+data "openai_chat_completion" "test" {
+	model = "gpt-4o-mini"
+	seed = 42
+	temperature = 0
+	messages = [
+		{
+			role = "system"
+			content = "Extract the colour mentioned by the user."
+		},
+		{
+			role = "user"
+			content = "The sky is blue today."
+		}
+	]
+	response_format = {
+		type = "json_schema"
+		json_schema = {
+			name = "colour"
+			strict = true
+			schema = jsonencode({
+				type = "object"
+				properties = {
+					colour = { type = "string" }
+				}
+				required = ["colour"]
+				additionalProperties = false
+			})
+		}
+	}
+}
+`
+
+func TestChatCompletionDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	client, recorder := newRecordingTestClient(t, `{
+		"id": "chatcmpl-123",
+		"object": "chat.completion",
+		"model": "gpt-4o-mini",
+		"system_fingerprint": "fp_123",
+		"choices": [{"index": 0, "finish_reason": "stop", "message": {"role": "assistant", "content": "{\"colour\": \"blue\", \"count\": 2, \"tags\": [\"sky\", true]}"}}],
+		"usage": {"prompt_tokens": 20, "completion_tokens": 8, "total_tokens": 28}
+	}`)
+	d := &ChatCompletionDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	config := ChatCompletionDataSourceModel{
+		Model: types.StringValue("gpt-4o-mini"),
+		Messages: []ChatCompletionMessageModel{
+			{Role: types.StringValue("user"), Content: types.StringValue("The sky is blue today."), Name: types.StringNull()},
+		},
+		ResponseFormat: &OpenAIAssistantResponseFormatModel{
+			Type: types.StringValue("json_schema"),
+			JsonSchema: &OpenAIAssistantResponseJsonSchemaModel{
+				Description: types.StringNull(),
+				Name:        types.StringValue("colour"),
+				Schema:      NewJSONStringValue(`{"type": "object"}`),
+				Strict:      types.BoolValue(true),
+			},
+		},
+		Seed:                types.Int64Value(42),
+		Temperature:         types.Float64Value(0),
+		MaxCompletionTokens: types.Int64Null(),
+		Content:             types.StringNull(),
+		Refusal:             types.StringNull(),
+		Parsed:              types.DynamicNull(),
+		FinishReason:        types.StringNull(),
+		SystemFingerprint:   types.StringNull(),
+		Usage:               types.ObjectNull(ChatCompletionUsageModel{}.AttrTypes()),
+	}
+
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	req := recorder.last(t)
+	assert.Equal(t, "/v1/chat/completions", req.Path)
+	assert.Equal(t, float64(42), req.Body["seed"])
+	assert.Equal(t, float64(0), req.Body["temperature"])
+	assert.NotContains(t, req.Body, "max_completion_tokens")
+	assert.Equal(t, map[string]interface{}{
+		"type": "json_schema",
+		"json_schema": map[string]interface{}{
+			"name":   "colour",
+			"schema": map[string]interface{}{"type": "object"},
+			"strict": true,
+		},
+	}, req.Body["response_format"])
+
+	var state ChatCompletionDataSourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "chatcmpl-123", state.Id.ValueString())
+	assert.Equal(t, "stop", state.FinishReason.ValueString())
+	assert.Equal(t, "fp_123", state.SystemFingerprint.ValueString())
+	assert.Equal(t, int64(28), state.Usage.Attributes()["total_tokens"].(types.Int64).ValueInt64())
+
+	parsed, ok := state.Parsed.UnderlyingValue().(types.Object)
+	if assert.True(t, ok, "parsed is %T", state.Parsed.UnderlyingValue()) {
+		assert.Equal(t, types.StringValue("blue"), parsed.Attributes()["colour"])
+		assert.Equal(t, "2", parsed.Attributes()["count"].(types.Number).ValueBigFloat().String())
+		assert.Len(t, parsed.Attributes()["tags"].(types.Tuple).Elements(), 2)
+	}
+}
+
+func TestDecodeJSONToDynamic(t *testing.T) {
+	value, err := decodeJSONToDynamic(`{"a": null, "b": [1, "x"], "c": {"d": false}}`)
+	assert.NoError(t, err)
+	obj := value.UnderlyingValue().(types.Object)
+	assert.True(t, obj.Attributes()["a"].IsNull())
+	assert.Equal(t, types.BoolValue(false), obj.Attributes()["c"].(types.Object).Attributes()["d"])
+
+	_, err = decodeJSONToDynamic(`not json`)
+	assert.Error(t, err)
+	_, err = decodeJSONToDynamic(`{} {}`)
+	assert.Error(t, err)
+}
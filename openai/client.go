@@ -0,0 +1,166 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/skyscrapr/openai-sdk-go/openai"
+)
+
+// OpenAIClient wraps the SDK client and keeps the credentials needed to call
+// API endpoints the SDK does not cover yet.
+type OpenAIClient struct {
+	*openai.Client
+
+	apiKey   string
+	adminKey string
+	// ctx is the context requests are sent with.
+	ctx context.Context
+}
+
+func NewOpenAIClient(apiKey string, adminKey string) *OpenAIClient {
+	return &OpenAIClient{
+		Client:   openai.NewClient(apiKey, adminKey),
+		apiKey:   apiKey,
+		adminKey: adminKey,
+		ctx:      context.Background(),
+	}
+}
+
+// WithContext returns a copy of the client that sends its requests with ctx,
+// so that they are cancelled with the Terraform operation they are made for
+// and logged with its fields. The SDK does not accept a context, so its
+// requests are given ctx by the transport.
+func (c *OpenAIClient) WithContext(ctx context.Context) *OpenAIClient {
+	sdk := *c.Client
+	httpClient := *c.HTTPClient
+	httpClient.Transport = &contextTransport{ctx: ctx, next: httpClient.Transport}
+	sdk.HTTPClient = &httpClient
+	return &OpenAIClient{
+		Client:   &sdk,
+		apiKey:   c.apiKey,
+		adminKey: c.adminKey,
+		ctx:      ctx,
+	}
+}
+
+// contextTransport sends requests with its context.
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return next.RoundTrip(req.WithContext(t.ctx))
+}
+
+// do sends a JSON request authenticated with the API key.
+func (c *OpenAIClient) do(method string, endpointPath string, body interface{}, values url.Values, result interface{}) error {
+	return c.doWithKey(c.apiKey, nil, method, endpointPath, body, values, result)
+}
+
+// doBeta sends a JSON request to an endpoint of the Assistants v2 beta.
+func (c *OpenAIClient) doBeta(method string, endpointPath string, body interface{}, values url.Values, result interface{}) error {
+	headers := http.Header{}
+	headers.Set("OpenAI-Beta", "assistants=v2")
+	return c.doWithKey(c.apiKey, headers, method, endpointPath, body, values, result)
+}
+
+// doAdmin sends a JSON request authenticated with the admin key.
+func (c *OpenAIClient) doAdmin(method string, endpointPath string, body interface{}, values url.Values, result interface{}) error {
+	return c.doWithKey(c.adminKey, nil, method, endpointPath, body, values, result)
+}
+
+func (c *OpenAIClient) doWithKey(key string, headers http.Header, method string, endpointPath string, body interface{}, values url.Values, result interface{}) error {
+	u := c.BaseURL.JoinPath("v1", endpointPath)
+	if values != nil {
+		u.RawQuery = values.Encode()
+	}
+
+	var buf io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		buf = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(c.ctx, method, u.String(), buf)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+	for k, v := range headers {
+		req.Header[k] = v
+	}
+	return c.send(key, req, result)
+}
+
+// send authenticates the request with the given key and decodes the JSON response into result.
+func (c *OpenAIClient) send(key string, req *http.Request, result interface{}) error {
+	req.Header.Set("Accept", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", key))
+	if len(c.OrganizationID) > 0 {
+		req.Header.Set("OpenAI-Organization", c.OrganizationID)
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		return decodeErrorResponse(res)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(result)
+}
+
+// decodeErrorResponse converts an error response into the same error types returned by the SDK.
+func decodeErrorResponse(res *http.Response) error {
+	var errRes openai.ErrorResponse
+	err := json.NewDecoder(res.Body).Decode(&errRes)
+	if err != nil || errRes.Error == nil {
+		reqErr := &openai.RequestError{
+			HTTPStatusCode: res.StatusCode,
+			Err:            err,
+		}
+		if reqErr.Err == nil {
+			reqErr.Err = fmt.Errorf("%s", http.StatusText(res.StatusCode))
+		}
+		return reqErr
+	}
+	errRes.Error.HTTPStatusCode = res.StatusCode
+	return errRes.Error
+}
+
+// ListResponse is the envelope returned by paginated list endpoints.
+type ListResponse[T any] struct {
+	Object  string `json:"object"`
+	Data    []T    `json:"data"`
+	FirstID string `json:"first_id"`
+	LastID  string `json:"last_id"`
+	HasMore bool   `json:"has_more"`
+}
+
+// DeletionStatus is returned by delete endpoints.
+type DeletionStatus struct {
+	Id      string `json:"id"`
+	Object  string `json:"object"`
+	Deleted bool   `json:"deleted"`
+}
@@ -0,0 +1,100 @@
+package openai
+
+import (
+	"net/url"
+	"strconv"
+)
+
+const AdminApiKeysEndpointPath = "organization/admin_api_keys"
+
+// AdminApiKeysEndpoint - OpenAI Admin API Keys API
+//
+//	Manage the admin keys of the organization. Requires an admin key.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/admin-api-keys
+type AdminApiKeysEndpoint struct {
+	client *OpenAIClient
+}
+
+// AdminApiKeys - Admin API Keys Endpoint
+func (c *OpenAIClient) AdminApiKeys() *AdminApiKeysEndpoint {
+	return &AdminApiKeysEndpoint{client: c}
+}
+
+type AdminApiKey struct {
+	Object        string `json:"object"` // The object type, which is always organization.admin_api_key.
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	RedactedValue string `json:"redacted_value"`
+	// The value of the key. Only returned when the key is created.
+	Value      *string           `json:"value,omitempty"`
+	CreatedAt  int64             `json:"created_at"`
+	LastUsedAt *int64            `json:"last_used_at"`
+	Owner      *AdminApiKeyOwner `json:"owner,omitempty"`
+}
+
+type AdminApiKeyOwner struct {
+	Type      string `json:"type"` // Always user.
+	Object    string `json:"object"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt int64  `json:"created_at"`
+	Role      string `json:"role"`
+}
+
+type CreateAdminApiKeyRequest struct {
+	Name string `json:"name"`
+}
+
+type ListAdminApiKeysRequest struct {
+	// A cursor for use in pagination. after is an object ID that defines your place in the list.
+	After *string
+	// A limit on the number of objects to be returned. Limit can range between 1 and 100.
+	Limit *int
+	// Sort order by the created_at timestamp of the objects. asc for ascending order and desc for descending order.
+	Order *string
+}
+
+// Create an organization admin API key.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/admin-api-keys/create
+func (e *AdminApiKeysEndpoint) CreateAdminApiKey(req *CreateAdminApiKeyRequest) (*AdminApiKey, error) {
+	var key AdminApiKey
+	err := e.client.doAdmin("POST", AdminApiKeysEndpointPath, req, nil, &key)
+	return &key, err
+}
+
+// Retrieve a single organization admin API key.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/admin-api-keys/listget
+func (e *AdminApiKeysEndpoint) RetrieveAdminApiKey(keyId string) (*AdminApiKey, error) {
+	var key AdminApiKey
+	err := e.client.doAdmin("GET", AdminApiKeysEndpointPath+"/"+url.PathEscape(keyId), nil, nil, &key)
+	return &key, err
+}
+
+// Delete an organization admin API key.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/admin-api-keys/delete
+func (e *AdminApiKeysEndpoint) DeleteAdminApiKey(keyId string) (bool, error) {
+	var status DeletionStatus
+	err := e.client.doAdmin("DELETE", AdminApiKeysEndpointPath+"/"+url.PathEscape(keyId), nil, nil, &status)
+	if err != nil {
+		return false, err
+	}
+	return status.Deleted, nil
+}
+
+// List organization admin API keys, including the cursors needed to page through them.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/admin-api-keys/list
+func (e *AdminApiKeysEndpoint) ListAdminApiKeys(req *ListAdminApiKeysRequest) (*ListResponse[AdminApiKey], error) {
+	v := url.Values{}
+	if req.After != nil {
+		v.Add("after", *req.After)
+	}
+	if req.Limit != nil {
+		v.Add("limit", strconv.Itoa(*req.Limit))
+	}
+	if req.Order != nil {
+		v.Add("order", *req.Order)
+	}
+	var keys ListResponse[AdminApiKey]
+	err := e.client.doAdmin("GET", AdminApiKeysEndpointPath, nil, v, &keys)
+	return &keys, err
+}
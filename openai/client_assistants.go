@@ -0,0 +1,168 @@
+package openai
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/skyscrapr/openai-sdk-go/openai"
+)
+
+const AssistantsEndpointPath = "assistants"
+
+// AssistantsEndpoint - OpenAI Assistants API
+//
+//	Build assistants that can call models and use tools to perform tasks.
+//	This replaces the SDK endpoint, whose tool types cannot carry file_search options.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/assistants
+type AssistantsEndpoint struct {
+	client *OpenAIClient
+}
+
+// Assistants - Assistants Endpoint
+func (c *OpenAIClient) Assistants() *AssistantsEndpoint {
+	return &AssistantsEndpoint{client: c}
+}
+
+type Assistant struct {
+	Id              string                         `json:"id"`
+	Object          string                         `json:"object"` // The object type, which is always assistant.
+	CreatedAt       int64                          `json:"created_at"`
+	Name            *string                        `json:"name"`
+	Description     *string                        `json:"description"`
+	Model           string                         `json:"model"`
+	Instructions    *string                        `json:"instructions"`
+	Tools           []AssistantTool                `json:"tools,omitempty"`
+	ToolResources   *openai.AssistantToolResources `json:"tool_resources,omitempty"`
+	MetaData        map[string]string              `json:"metadata,omitempty"`
+	Temperature     *float64                       `json:"temperature,omitempty"`
+	TopP            *float64                       `json:"top_p,omitempty"`
+	ReasoningEffort *string                        `json:"reasoning_effort,omitempty"`
+	ResponseFormat  *AssistantResponseFormat       `json:"response_format,omitempty"`
+}
+
+type AssistantRequest struct {
+	// ID of the model to use.
+	Model string `json:"model"`
+	// The name of the assistant. The maximum length is 256 characters.
+	Name *string `json:"name"`
+	// The description of the assistant. The maximum length is 512 characters.
+	Description *string `json:"description"`
+	// The system instructions that the assistant uses. The maximum length is 32768 characters.
+	Instructions *string `json:"instructions"`
+	// A list of tool enabled on the assistant. There can be a maximum of 128 tools per assistant.
+	Tools []AssistantTool `json:"tools"`
+	// A set of resources that are used by the assistant's tools.
+	ToolResources *openai.AssistantToolResources `json:"tool_resources,omitempty"`
+	// Set of 16 key-value pairs that can be attached to an object.
+	MetaData map[string]string `json:"metadata"`
+	// What sampling temperature to use, between 0 and 2. Not supported by reasoning models.
+	Temperature *float64 `json:"temperature,omitempty"`
+	// An alternative to sampling with temperature, called nucleus sampling. Not supported by reasoning models.
+	TopP *float64 `json:"top_p,omitempty"`
+	// Constrains effort on reasoning for reasoning models: low, medium or high.
+	ReasoningEffort *string `json:"reasoning_effort,omitempty"`
+	// Specifies the format that the model must output.
+	ResponseFormat *AssistantResponseFormat `json:"response_format,omitempty"`
+}
+
+// AssistantResponseFormat is either the string "auto" or a response format object.
+type AssistantResponseFormat struct {
+	openai.AssistantResponseFormat
+}
+
+func (f AssistantResponseFormat) MarshalJSON() ([]byte, error) {
+	if f.StringValue != "" {
+		return json.Marshal(f.StringValue)
+	}
+	type responseFormat openai.AssistantResponseFormat
+	return json.Marshal(responseFormat(f.AssistantResponseFormat))
+}
+
+type AssistantTool struct {
+	Type       string                   `json:"type"`
+	Function   *AssistantToolFunction   `json:"function,omitempty"`
+	FileSearch *AssistantToolFileSearch `json:"file_search,omitempty"`
+}
+
+type AssistantToolFunction struct {
+	Description *string                `json:"description,omitempty"`
+	Name        string                 `json:"name"`
+	Parameters  map[string]interface{} `json:"parameters"`
+}
+
+type AssistantToolFileSearch struct {
+	// The maximum number of results the file search tool should output, between 1 and 50.
+	MaxNumResults *int64 `json:"max_num_results,omitempty"`
+	// The ranking options for the file search.
+	RankingOptions *AssistantToolFileSearchRankingOptions `json:"ranking_options,omitempty"`
+}
+
+type AssistantToolFileSearchRankingOptions struct {
+	// The ranker to use for the file search, auto or default_2024_08_21.
+	Ranker *string `json:"ranker,omitempty"`
+	// The score threshold for the file search, a number between 0 and 1.
+	ScoreThreshold float64 `json:"score_threshold"`
+}
+
+type ListAssistantsRequest struct {
+	// A cursor for use in pagination. after is an object ID that defines your place in the list.
+	After *string
+	// A limit on the number of objects to be returned. Limit can range between 1 and 100.
+	Limit *int
+	// Sort order by the created_at timestamp of the objects. asc for ascending order and desc for descending order.
+	Order *string
+}
+
+// Create an assistant with a model and instructions.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/assistants/createAssistant
+func (e *AssistantsEndpoint) CreateAssistant(req *AssistantRequest) (*Assistant, error) {
+	var assistant Assistant
+	err := e.client.doBeta("POST", AssistantsEndpointPath, req, nil, &assistant)
+	return &assistant, err
+}
+
+// Retrieves an assistant.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/assistants/getAssistant
+func (e *AssistantsEndpoint) RetrieveAssistant(assistantId string) (*Assistant, error) {
+	var assistant Assistant
+	err := e.client.doBeta("GET", AssistantsEndpointPath+"/"+url.PathEscape(assistantId), nil, nil, &assistant)
+	return &assistant, err
+}
+
+// Modifies an assistant.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/assistants/modifyAssistant
+func (e *AssistantsEndpoint) ModifyAssistant(assistantId string, req *AssistantRequest) (*Assistant, error) {
+	var assistant Assistant
+	err := e.client.doBeta("POST", AssistantsEndpointPath+"/"+url.PathEscape(assistantId), req, nil, &assistant)
+	return &assistant, err
+}
+
+// Deletes an assistant.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/assistants/deleteAssistant
+func (e *AssistantsEndpoint) DeleteAssistant(assistantId string) (bool, error) {
+	var status DeletionStatus
+	err := e.client.doBeta("DELETE", AssistantsEndpointPath+"/"+url.PathEscape(assistantId), nil, nil, &status)
+	if err != nil {
+		return false, err
+	}
+	return status.Deleted, nil
+}
+
+// Returns a list of assistants, including the cursors needed to page through them.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/assistants/listAssistants
+func (e *AssistantsEndpoint) ListAssistants(req *ListAssistantsRequest) (*ListResponse[Assistant], error) {
+	v := url.Values{}
+	if req.After != nil {
+		v.Add("after", *req.After)
+	}
+	if req.Limit != nil {
+		v.Add("limit", strconv.Itoa(*req.Limit))
+	}
+	if req.Order != nil {
+		v.Add("order", *req.Order)
+	}
+	var assistants ListResponse[Assistant]
+	err := e.client.doBeta("GET", AssistantsEndpointPath, nil, v, &assistants)
+	return &assistants, err
+}
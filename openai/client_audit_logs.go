@@ -0,0 +1,160 @@
+package openai
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+const AuditLogsEndpointPath = "organization/audit_logs"
+
+// AuditLogsEndpoint - OpenAI Audit Logs API
+//
+//	Logs of user actions and configuration changes within the organization.
+//	Requires an admin key.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/audit-logs
+type AuditLogsEndpoint struct {
+	client *OpenAIClient
+}
+
+// AuditLogs - Audit Logs Endpoint
+func (c *OpenAIClient) AuditLogs() *AuditLogsEndpoint {
+	return &AuditLogsEndpoint{client: c}
+}
+
+type AuditLog struct {
+	// The ID of this log.
+	Id string `json:"id"`
+	// The event type.
+	Type string `json:"type"`
+	// The Unix timestamp (in seconds) of the event.
+	EffectiveAt int64 `json:"effective_at"`
+	// The project that the action was scoped to. Absent for actions not scoped to projects.
+	Project *AuditLogProject `json:"project,omitempty"`
+	// The actor who performed the audit logged action.
+	Actor *AuditLogActor `json:"actor,omitempty"`
+	// Details is the event specific object, which the API returns under a key named after the event type.
+	Details json.RawMessage `json:"-"`
+}
+
+type AuditLogProject struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type AuditLogActor struct {
+	// The type of actor. Is either session or api_key.
+	Type    string                `json:"type"`
+	Session *AuditLogActorSession `json:"session,omitempty"`
+	ApiKey  *AuditLogActorApiKey  `json:"api_key,omitempty"`
+}
+
+type AuditLogActorSession struct {
+	User      *AuditLogActorUser `json:"user,omitempty"`
+	IpAddress string             `json:"ip_address"`
+}
+
+type AuditLogActorApiKey struct {
+	Id string `json:"id"`
+	// The type of API key. Can be either user or service_account.
+	Type           string                       `json:"type"`
+	User           *AuditLogActorUser           `json:"user,omitempty"`
+	ServiceAccount *AuditLogActorServiceAccount `json:"service_account,omitempty"`
+}
+
+type AuditLogActorUser struct {
+	Id    string `json:"id"`
+	Email string `json:"email"`
+}
+
+type AuditLogActorServiceAccount struct {
+	Id string `json:"id"`
+}
+
+func (l *AuditLog) UnmarshalJSON(data []byte) error {
+	type auditLog AuditLog
+	if err := json.Unmarshal(data, (*auditLog)(l)); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	l.Details = fields[l.Type]
+	return nil
+}
+
+// ResourceId returns the ID of the object the event acted on, if the event details include one.
+func (l *AuditLog) ResourceId() *string {
+	var details struct {
+		Id *string `json:"id"`
+	}
+	if len(l.Details) == 0 || json.Unmarshal(l.Details, &details) != nil {
+		return nil
+	}
+	return details.Id
+}
+
+type ListAuditLogsRequest struct {
+	// Return only events whose effective_at (Unix seconds) is in these ranges.
+	EffectiveAtGt  *int64
+	EffectiveAtGte *int64
+	EffectiveAtLt  *int64
+	EffectiveAtLte *int64
+	// Return only events for these projects.
+	ProjectIds []string
+	// Return only events with a type in this list.
+	EventTypes []string
+	// Return only events performed by these actors. Can be a user ID, a service account ID, or an api key tracking ID.
+	ActorIds []string
+	// Return only events performed by users with these emails.
+	ActorEmails []string
+	// Return only events performed on these targets.
+	ResourceIds []string
+	// A limit on the number of objects to be returned. Limit can range between 1 and 100.
+	Limit *int
+	// A cursor for use in pagination. after is an object ID that defines your place in the list.
+	After *string
+}
+
+func (r *ListAuditLogsRequest) values() url.Values {
+	v := url.Values{}
+	ranges := map[string]*int64{
+		"effective_at[gt]":  r.EffectiveAtGt,
+		"effective_at[gte]": r.EffectiveAtGte,
+		"effective_at[lt]":  r.EffectiveAtLt,
+		"effective_at[lte]": r.EffectiveAtLte,
+	}
+	for k, t := range ranges {
+		if t != nil {
+			v.Add(k, strconv.FormatInt(*t, 10))
+		}
+	}
+	lists := map[string][]string{
+		"project_ids[]":  r.ProjectIds,
+		"event_types[]":  r.EventTypes,
+		"actor_ids[]":    r.ActorIds,
+		"actor_emails[]": r.ActorEmails,
+		"resource_ids[]": r.ResourceIds,
+	}
+	for k, values := range lists {
+		for _, value := range values {
+			v.Add(k, value)
+		}
+	}
+	if r.Limit != nil {
+		v.Add("limit", strconv.Itoa(*r.Limit))
+	}
+	if r.After != nil {
+		v.Add("after", *r.After)
+	}
+	return v
+}
+
+// List user actions and configuration changes within this organization.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/audit-logs/list
+func (e *AuditLogsEndpoint) ListAuditLogs(req *ListAuditLogsRequest) (*ListResponse[AuditLog], error) {
+	var logs ListResponse[AuditLog]
+	err := e.client.doAdmin("GET", AuditLogsEndpointPath, nil, req.values(), &logs)
+	return &logs, err
+}
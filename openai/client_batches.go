@@ -0,0 +1,99 @@
+package openai
+
+import (
+	"net/url"
+	"strconv"
+)
+
+const BatchesEndpointPath = "batches"
+
+// BatchesEndpoint - OpenAI Batch API
+//
+//	Create large batches of API requests for asynchronous processing.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/batch
+type BatchesEndpoint struct {
+	client *OpenAIClient
+}
+
+// Batches - Batches Endpoint
+func (c *OpenAIClient) Batches() *BatchesEndpoint {
+	return &BatchesEndpoint{client: c}
+}
+
+type Batch struct {
+	Id               string            `json:"id"`
+	Object           string            `json:"object"`
+	Endpoint         string            `json:"endpoint"`
+	InputFileId      string            `json:"input_file_id"`
+	CompletionWindow string            `json:"completion_window"`
+	Status           string            `json:"status"`
+	OutputFileId     *string           `json:"output_file_id"`
+	ErrorFileId      *string           `json:"error_file_id"`
+	CreatedAt        int64             `json:"created_at"`
+	InProgressAt     *int64            `json:"in_progress_at"`
+	ExpiresAt        *int64            `json:"expires_at"`
+	FinalizingAt     *int64            `json:"finalizing_at"`
+	CompletedAt      *int64            `json:"completed_at"`
+	FailedAt         *int64            `json:"failed_at"`
+	ExpiredAt        *int64            `json:"expired_at"`
+	CancellingAt     *int64            `json:"cancelling_at"`
+	CancelledAt      *int64            `json:"cancelled_at"`
+	RequestCounts    *BatchCounts      `json:"request_counts"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+}
+
+type BatchCounts struct {
+	Total     int64 `json:"total"`
+	Completed int64 `json:"completed"`
+	Failed    int64 `json:"failed"`
+}
+
+type CreateBatchRequest struct {
+	// The ID of an uploaded file that contains requests for the new batch.
+	InputFileId string `json:"input_file_id"`
+	// The endpoint to be used for all requests in the batch.
+	Endpoint string `json:"endpoint"`
+	// The time frame within which the batch should be processed. Currently only 24h is supported.
+	CompletionWindow string `json:"completion_window"`
+	// Optional custom metadata for the batch.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Creates and executes a batch from an uploaded file of requests.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/batch/create
+func (e *BatchesEndpoint) CreateBatch(req *CreateBatchRequest) (*Batch, error) {
+	var batch Batch
+	err := e.client.do("POST", BatchesEndpointPath, req, nil, &batch)
+	return &batch, err
+}
+
+// Retrieves a batch.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/batch/retrieve
+func (e *BatchesEndpoint) RetrieveBatch(batchId string) (*Batch, error) {
+	var batch Batch
+	err := e.client.do("GET", BatchesEndpointPath+"/"+url.PathEscape(batchId), nil, nil, &batch)
+	return &batch, err
+}
+
+// Cancels an in-progress batch.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/batch/cancel
+func (e *BatchesEndpoint) CancelBatch(batchId string) (*Batch, error) {
+	var batch Batch
+	err := e.client.do("POST", BatchesEndpointPath+"/"+url.PathEscape(batchId)+"/cancel", nil, nil, &batch)
+	return &batch, err
+}
+
+// List your organization's batches.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/batch/list
+func (e *BatchesEndpoint) ListBatches(after *string, limit *int) (*ListResponse[Batch], error) {
+	v := url.Values{}
+	if after != nil {
+		v.Add("after", *after)
+	}
+	if limit != nil {
+		v.Add("limit", strconv.Itoa(*limit))
+	}
+	var batches ListResponse[Batch]
+	err := e.client.do("GET", BatchesEndpointPath, nil, v, &batches)
+	return &batches, err
+}
@@ -0,0 +1,75 @@
+package openai
+
+const ChatCompletionsEndpointPath = "chat/completions"
+
+// ChatCompletionsEndpoint - OpenAI Chat Completions API
+//
+//	Creates a model response for the given chat conversation.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/chat
+type ChatCompletionsEndpoint struct {
+	client *OpenAIClient
+}
+
+// ChatCompletions - Chat Completions Endpoint
+func (c *OpenAIClient) ChatCompletions() *ChatCompletionsEndpoint {
+	return &ChatCompletionsEndpoint{client: c}
+}
+
+type ChatCompletionMessage struct {
+	// The role of the author of this message: developer, system, user or assistant.
+	Role string `json:"role"`
+	// The contents of the message.
+	Content string `json:"content"`
+	// An optional name for the participant.
+	Name *string `json:"name,omitempty"`
+}
+
+type ChatCompletionRequest struct {
+	// ID of the model to use.
+	Model string `json:"model"`
+	// A list of messages comprising the conversation so far.
+	Messages []ChatCompletionMessage `json:"messages"`
+	// An object specifying the format that the model must output.
+	ResponseFormat *AssistantResponseFormat `json:"response_format,omitempty"`
+	// If specified, the system will make a best effort to sample deterministically.
+	Seed *int64 `json:"seed,omitempty"`
+	// What sampling temperature to use, between 0 and 2.
+	Temperature *float64 `json:"temperature,omitempty"`
+	// An upper bound for the number of tokens that can be generated for a completion, including reasoning tokens.
+	MaxCompletionTokens *int64 `json:"max_completion_tokens,omitempty"`
+}
+
+type ChatCompletion struct {
+	Id                string                 `json:"id"`
+	Object            string                 `json:"object"` // The object type, which is always chat.completion.
+	Created           int64                  `json:"created"`
+	Model             string                 `json:"model"`
+	SystemFingerprint *string                `json:"system_fingerprint"`
+	Choices           []ChatCompletionChoice `json:"choices"`
+	Usage             *ChatCompletionUsage   `json:"usage"`
+}
+
+type ChatCompletionChoice struct {
+	Index int64 `json:"index"`
+	// The reason the model stopped generating tokens: stop, length, tool_calls, content_filter or function_call.
+	FinishReason string `json:"finish_reason"`
+	Message      struct {
+		Role    string  `json:"role"`
+		Content *string `json:"content"`
+		Refusal *string `json:"refusal"`
+	} `json:"message"`
+}
+
+type ChatCompletionUsage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+	TotalTokens      int64 `json:"total_tokens"`
+}
+
+// Creates a model response for the given chat conversation.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/chat/create
+func (e *ChatCompletionsEndpoint) CreateChatCompletion(req *ChatCompletionRequest) (*ChatCompletion, error) {
+	var completion ChatCompletion
+	err := e.client.do("POST", ChatCompletionsEndpointPath, req, nil, &completion)
+	return &completion, err
+}
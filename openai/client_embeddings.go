@@ -0,0 +1,54 @@
+package openai
+
+import "encoding/json"
+
+const EmbeddingsEndpointPath = "embeddings"
+
+// EmbeddingsEndpoint - OpenAI Embeddings API
+//
+//	Get a vector representation of a given input that can be easily consumed by machine learning models and algorithms.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/embeddings
+type EmbeddingsEndpoint struct {
+	client *OpenAIClient
+}
+
+// Embeddings - Embeddings Endpoint
+func (c *OpenAIClient) Embeddings() *EmbeddingsEndpoint {
+	return &EmbeddingsEndpoint{client: c}
+}
+
+type EmbeddingsRequest struct {
+	// ID of the model to use.
+	Model string `json:"model"`
+	// Input text to embed. Each input must not exceed the max input tokens for the model.
+	Input []string `json:"input"`
+	// The number of dimensions the resulting output embeddings should have. Only supported in text-embedding-3 and later models.
+	Dimensions *int64 `json:"dimensions,omitempty"`
+	// The format to return the embeddings in: float or base64.
+	EncodingFormat *string `json:"encoding_format,omitempty"`
+}
+
+type Embeddings struct {
+	Object string      `json:"object"` // The object type, which is always list.
+	Model  string      `json:"model"`
+	Data   []Embedding `json:"data"`
+	Usage  *struct {
+		PromptTokens int64 `json:"prompt_tokens"`
+		TotalTokens  int64 `json:"total_tokens"`
+	} `json:"usage"`
+}
+
+type Embedding struct {
+	Object string `json:"object"` // The object type, which is always embedding.
+	Index  int64  `json:"index"`
+	// The embedding vector, a list of floats or a base64 string depending on the encoding_format.
+	Embedding json.RawMessage `json:"embedding"`
+}
+
+// Creates an embedding vector representing the input text.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/embeddings/create
+func (e *EmbeddingsEndpoint) CreateEmbeddings(req *EmbeddingsRequest) (*Embeddings, error) {
+	var embeddings Embeddings
+	err := e.client.do("POST", EmbeddingsEndpointPath, req, nil, &embeddings)
+	return &embeddings, err
+}
@@ -0,0 +1,54 @@
+package openai
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/skyscrapr/openai-sdk-go/openai"
+)
+
+const FilesEndpointPath = "files"
+
+type UploadFileContentRequest struct {
+	// The name the file is uploaded with.
+	Filename string
+	// The intended purpose of the uploaded file.
+	Purpose string
+	// The file content.
+	Content io.Reader
+}
+
+// Upload a file from in-memory content rather than a path on disk.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/files/create
+func (c *OpenAIClient) UploadFileContent(req *UploadFileContentRequest) (*openai.File, error) {
+	var b bytes.Buffer
+	writer := multipart.NewWriter(&b)
+	err := writer.WriteField("purpose", req.Purpose)
+	if err != nil {
+		return nil, err
+	}
+	fieldWriter, err := writer.CreateFormFile("file", req.Filename)
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.Copy(fieldWriter, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := http.NewRequest("POST", c.BaseURL.JoinPath("v1", FilesEndpointPath).String(), &b)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var file openai.File
+	err = c.send(c.apiKey, r, &file)
+	return &file, err
+}
@@ -0,0 +1,27 @@
+package openai
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// FineTuningJobError is the error that caused a fine-tuning job to fail, which
+// the SDK does not decode.
+type FineTuningJobError struct {
+	// A machine-readable error code.
+	Code string `json:"code"`
+	// A human-readable error message.
+	Message string `json:"message"`
+	// The parameter that was invalid, usually training_file or validation_file.
+	Param *string `json:"param"`
+}
+
+// Retrieves the error of a failed fine-tuning job, which is nil when the job has none.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/fine-tuning/retrieve
+func (c *OpenAIClient) RetrieveFineTuningJobError(jobId string) (*FineTuningJobError, error) {
+	var job struct {
+		Error *FineTuningJobError `json:"error"`
+	}
+	err := c.do(http.MethodGet, "fine_tuning/jobs/"+url.PathEscape(jobId), nil, nil, &job)
+	return job.Error, err
+}
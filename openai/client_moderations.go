@@ -0,0 +1,58 @@
+package openai
+
+const ModerationsEndpointPath = "moderations"
+
+// ModerationsEndpoint - OpenAI Moderations API
+//
+//	Given text and/or image inputs, classifies if those inputs are potentially harmful.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/moderations
+type ModerationsEndpoint struct {
+	client *OpenAIClient
+}
+
+// Moderations - Moderations Endpoint
+func (c *OpenAIClient) Moderations() *ModerationsEndpoint {
+	return &ModerationsEndpoint{client: c}
+}
+
+type ModerationRequest struct {
+	// Input to classify. Either a list of strings, or a list of ModerationInput for multi-modal models.
+	Input interface{} `json:"input"`
+	// The content moderation model to use. Defaults to omni-moderation-latest.
+	Model *string `json:"model,omitempty"`
+}
+
+type ModerationInput struct {
+	// The type of input: text or image_url.
+	Type     string              `json:"type"`
+	Text     *string             `json:"text,omitempty"`
+	ImageURL *ModerationImageURL `json:"image_url,omitempty"`
+}
+
+type ModerationImageURL struct {
+	// Either a URL of the image or the base64 encoded image data.
+	URL string `json:"url"`
+}
+
+type Moderation struct {
+	Id      string             `json:"id"`
+	Model   string             `json:"model"`
+	Results []ModerationResult `json:"results"`
+}
+
+type ModerationResult struct {
+	// Whether any of the categories are flagged.
+	Flagged bool `json:"flagged"`
+	// The categories and whether they are flagged.
+	Categories map[string]bool `json:"categories"`
+	// The categories and the scores predicted by the model.
+	CategoryScores map[string]float64 `json:"category_scores"`
+}
+
+// Classifies if text and/or image inputs are potentially harmful.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/moderations/create
+func (e *ModerationsEndpoint) CreateModeration(req *ModerationRequest) (*Moderation, error) {
+	var moderation Moderation
+	err := e.client.do("POST", ModerationsEndpointPath, req, nil, &moderation)
+	return &moderation, err
+}
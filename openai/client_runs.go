@@ -0,0 +1,113 @@
+package openai
+
+import (
+	"net/url"
+)
+
+// Runs - OpenAI Runs API
+//
+//	Represents an execution run on a thread.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/runs
+type Run struct {
+	Id                string            `json:"id"`
+	Object            string            `json:"object"` // The object type, which is always thread.run.
+	CreatedAt         int64             `json:"created_at"`
+	ThreadId          string            `json:"thread_id"`
+	AssistantId       string            `json:"assistant_id"`
+	Status            string            `json:"status"`
+	Model             string            `json:"model"`
+	LastError         *RunError         `json:"last_error"`
+	IncompleteDetails *RunIncomplete    `json:"incomplete_details"`
+	CompletedAt       *int64            `json:"completed_at"`
+	Usage             *RunUsage         `json:"usage"`
+	MetaData          map[string]string `json:"metadata"`
+}
+
+type RunError struct {
+	// One of server_error, rate_limit_exceeded, or invalid_prompt.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type RunIncomplete struct {
+	// The reason why the run is incomplete.
+	Reason string `json:"reason"`
+}
+
+type RunUsage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+	TotalTokens      int64 `json:"total_tokens"`
+}
+
+type CreateThreadAndRunRequest struct {
+	// The ID of the assistant to use to execute this run.
+	AssistantId string `json:"assistant_id"`
+	// The thread to create and run.
+	Thread *CreateThreadRequest `json:"thread,omitempty"`
+}
+
+type ThreadMessage struct {
+	Id          string                 `json:"id"`
+	Object      string                 `json:"object"` // The object type, which is always thread.message.
+	CreatedAt   int64                  `json:"created_at"`
+	ThreadId    string                 `json:"thread_id"`
+	Role        string                 `json:"role"`
+	Content     []ThreadMessageContent `json:"content"`
+	AssistantId *string                `json:"assistant_id"`
+	RunId       *string                `json:"run_id"`
+}
+
+type ThreadMessageContent struct {
+	// The type of content: text, image_file, image_url or refusal.
+	Type string `json:"type"`
+	Text *struct {
+		Value string `json:"value"`
+	} `json:"text,omitempty"`
+}
+
+type ListThreadMessagesRequest struct {
+	// Filter messages by the run ID that generated them.
+	RunId *string
+	// Sort order by the created_at timestamp of the objects. asc for ascending order and desc for descending order.
+	Order *string
+}
+
+// Create a thread and run it in one request.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/runs/createThreadAndRun
+func (e *ThreadsEndpoint) CreateThreadAndRun(req *CreateThreadAndRunRequest) (*Run, error) {
+	var run Run
+	err := e.client.doBeta("POST", ThreadsEndpointPath+"/runs", req, nil, &run)
+	return &run, err
+}
+
+// Retrieves a run.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/runs/getRun
+func (e *ThreadsEndpoint) RetrieveRun(threadId string, runId string) (*Run, error) {
+	var run Run
+	err := e.client.doBeta("GET", ThreadsEndpointPath+"/"+url.PathEscape(threadId)+"/runs/"+url.PathEscape(runId), nil, nil, &run)
+	return &run, err
+}
+
+// Cancels a run that is in_progress.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/runs/cancelRun
+func (e *ThreadsEndpoint) CancelRun(threadId string, runId string) (*Run, error) {
+	var run Run
+	err := e.client.doBeta("POST", ThreadsEndpointPath+"/"+url.PathEscape(threadId)+"/runs/"+url.PathEscape(runId)+"/cancel", nil, nil, &run)
+	return &run, err
+}
+
+// Returns a list of messages for a given thread.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/messages/listMessages
+func (e *ThreadsEndpoint) ListMessages(threadId string, req *ListThreadMessagesRequest) (*ListResponse[ThreadMessage], error) {
+	v := url.Values{}
+	if req.RunId != nil {
+		v.Add("run_id", *req.RunId)
+	}
+	if req.Order != nil {
+		v.Add("order", *req.Order)
+	}
+	var messages ListResponse[ThreadMessage]
+	err := e.client.doBeta("GET", ThreadsEndpointPath+"/"+url.PathEscape(threadId)+"/messages", nil, v, &messages)
+	return &messages, err
+}
@@ -0,0 +1,104 @@
+package openai
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestOpenAIClient(t *testing.T, handler http.HandlerFunc) *OpenAIClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewOpenAIClient("test-api-key", "test-admin-key")
+	client.BaseURL, _ = url.Parse(server.URL)
+	return client
+}
+
+func TestOpenAIClient_Do(t *testing.T) {
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/batches/batch_123", r.URL.Path)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"id": "batch_123", "object": "batch", "status": "in_progress"}`))
+	})
+
+	batch, err := client.Batches().RetrieveBatch("batch_123")
+	assert.NoError(t, err)
+	assert.Equal(t, "in_progress", batch.Status)
+}
+
+func TestOpenAIClient_DoAdmin(t *testing.T) {
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-admin-key", r.Header.Get("Authorization"))
+		w.Write([]byte(`{}`))
+	})
+
+	assert.NoError(t, client.doAdmin("GET", "organization/projects", nil, nil, nil))
+}
+
+func TestOpenAIClient_APIError(t *testing.T) {
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"message": "No batch found", "type": "invalid_request_error", "code": null}}`))
+	})
+
+	_, err := client.Batches().RetrieveBatch("batch_missing")
+	apiError := GetOpenAIAPIError(err)
+	if assert.NotNil(t, apiError) {
+		assert.Equal(t, http.StatusNotFound, apiError.HTTPStatusCode)
+		assert.Equal(t, "No batch found", apiError.Message)
+	}
+}
+
+func TestOpenAIClient_UploadFileContent(t *testing.T) {
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/files", r.URL.Path)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+		assert.Equal(t, "batch", r.FormValue("purpose"))
+		file, header, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			defer file.Close()
+			content, _ := io.ReadAll(file)
+			assert.Equal(t, "batch_input.jsonl", header.Filename)
+			assert.Equal(t, "{}\n", string(content))
+		}
+		w.Write([]byte(`{"id": "file-123", "object": "file", "filename": "batch_input.jsonl", "purpose": "batch"}`))
+	})
+
+	file, err := client.UploadFileContent(&UploadFileContentRequest{
+		Filename: "batch_input.jsonl",
+		Purpose:  "batch",
+		Content:  strings.NewReader("{}\n"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "file-123", file.Id)
+}
+
+func TestOpenAIClient_ListAssistants(t *testing.T) {
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/assistants", r.URL.Path)
+		assert.Equal(t, "assistants=v2", r.Header.Get("OpenAI-Beta"))
+		assert.Equal(t, "asc", r.URL.Query().Get("order"))
+		switch r.URL.Query().Get("after") {
+		case "":
+			w.Write([]byte(`{"object": "list", "data": [{"id": "asst_1"}], "last_id": "asst_1", "has_more": true}`))
+		case "asst_1":
+			w.Write([]byte(`{"object": "list", "data": [{"id": "asst_2"}], "last_id": "asst_2", "has_more": false}`))
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("after"))
+		}
+	})
+
+	order := "asc"
+	assistants, err := listAllAssistants(client, &order)
+	assert.NoError(t, err)
+	if assert.Len(t, assistants, 2) {
+		assert.Equal(t, "asst_1", assistants[0].Id)
+		assert.Equal(t, "asst_2", assistants[1].Id)
+	}
+}
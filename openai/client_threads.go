@@ -0,0 +1,102 @@
+package openai
+
+import (
+	"net/url"
+
+	"github.com/skyscrapr/openai-sdk-go/openai"
+)
+
+const ThreadsEndpointPath = "threads"
+
+// ThreadsEndpoint - OpenAI Threads API
+//
+//	Create threads that assistants can interact with.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/threads
+type ThreadsEndpoint struct {
+	client *OpenAIClient
+}
+
+// Threads - Threads Endpoint
+func (c *OpenAIClient) Threads() *ThreadsEndpoint {
+	return &ThreadsEndpoint{client: c}
+}
+
+type Thread struct {
+	Id            string                         `json:"id"`
+	Object        string                         `json:"object"` // The object type, which is always thread.
+	CreatedAt     int64                          `json:"created_at"`
+	ToolResources *openai.AssistantToolResources `json:"tool_resources"`
+	MetaData      map[string]string              `json:"metadata"`
+}
+
+type ThreadMessageRequest struct {
+	// The role of the entity that is creating the message: user or assistant.
+	Role string `json:"role"`
+	// The text contents of the message.
+	Content string `json:"content"`
+	// A list of files attached to the message, and the tools they should be added to.
+	Attachments []ThreadMessageAttachment `json:"attachments,omitempty"`
+}
+
+type ThreadMessageAttachment struct {
+	// The ID of the file to attach to the message.
+	FileId string `json:"file_id"`
+	// The tools to add this file to.
+	Tools []ThreadMessageAttachmentTool `json:"tools,omitempty"`
+}
+
+type ThreadMessageAttachmentTool struct {
+	// The type of tool: code_interpreter or file_search.
+	Type string `json:"type"`
+}
+
+type CreateThreadRequest struct {
+	// A list of messages to start the thread with.
+	Messages []ThreadMessageRequest `json:"messages,omitempty"`
+	// A set of resources that are made available to the assistant's tools in this thread.
+	ToolResources *openai.AssistantToolResources `json:"tool_resources,omitempty"`
+	// Set of 16 key-value pairs that can be attached to an object.
+	MetaData map[string]string `json:"metadata,omitempty"`
+}
+
+type ModifyThreadRequest struct {
+	// A set of resources that are made available to the assistant's tools in this thread.
+	ToolResources *openai.AssistantToolResources `json:"tool_resources"`
+	// Set of 16 key-value pairs that can be attached to an object.
+	MetaData map[string]string `json:"metadata"`
+}
+
+// Create a thread.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/threads/createThread
+func (e *ThreadsEndpoint) CreateThread(req *CreateThreadRequest) (*Thread, error) {
+	var thread Thread
+	err := e.client.doBeta("POST", ThreadsEndpointPath, req, nil, &thread)
+	return &thread, err
+}
+
+// Retrieves a thread.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/threads/getThread
+func (e *ThreadsEndpoint) RetrieveThread(threadId string) (*Thread, error) {
+	var thread Thread
+	err := e.client.doBeta("GET", ThreadsEndpointPath+"/"+url.PathEscape(threadId), nil, nil, &thread)
+	return &thread, err
+}
+
+// Modifies a thread.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/threads/modifyThread
+func (e *ThreadsEndpoint) ModifyThread(threadId string, req *ModifyThreadRequest) (*Thread, error) {
+	var thread Thread
+	err := e.client.doBeta("POST", ThreadsEndpointPath+"/"+url.PathEscape(threadId), req, nil, &thread)
+	return &thread, err
+}
+
+// Delete a thread.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/threads/deleteThread
+func (e *ThreadsEndpoint) DeleteThread(threadId string) (bool, error) {
+	var status DeletionStatus
+	err := e.client.doBeta("DELETE", ThreadsEndpointPath+"/"+url.PathEscape(threadId), nil, nil, &status)
+	if err != nil {
+		return false, err
+	}
+	return status.Deleted, nil
+}
@@ -0,0 +1,148 @@
+package openai
+
+import (
+	"net/url"
+	"strconv"
+)
+
+const (
+	UsageEndpointPath = "organization/usage"
+	CostsEndpointPath = "organization/costs"
+)
+
+// UsageEndpoint - OpenAI Usage API
+//
+//	Provides detailed insights into the activities across an organization.
+//	Requires an admin key.
+//	[OpenAI Documentation]: https://platform.openai.com/docs/api-reference/usage
+type UsageEndpoint struct {
+	client *OpenAIClient
+}
+
+// Usage - Usage Endpoint
+func (c *OpenAIClient) Usage() *UsageEndpoint {
+	return &UsageEndpoint{client: c}
+}
+
+type UsageRequest struct {
+	// Start time (Unix seconds) of the query time range, inclusive.
+	StartTime int64
+	// End time (Unix seconds) of the query time range, exclusive.
+	EndTime *int64
+	// Width of each time bucket in response: 1m, 1h or 1d.
+	BucketWidth *string
+	// Return only usage for these projects.
+	ProjectIds []string
+	// Group the usage data by the specified fields.
+	GroupBy []string
+	// Return only usage for these users.
+	UserIds []string
+	// Return only usage for these API keys.
+	ApiKeyIds []string
+	// Return only usage for these models.
+	Models []string
+	// If true, return batch jobs only. If false, return non-batch jobs only.
+	Batch *bool
+	// A cursor for use in pagination, from the next_page of a previous response.
+	Page *string
+}
+
+func (r *UsageRequest) values() url.Values {
+	v := url.Values{}
+	v.Add("start_time", strconv.FormatInt(r.StartTime, 10))
+	if r.EndTime != nil {
+		v.Add("end_time", strconv.FormatInt(*r.EndTime, 10))
+	}
+	if r.BucketWidth != nil {
+		v.Add("bucket_width", *r.BucketWidth)
+	}
+	for _, id := range r.ProjectIds {
+		v.Add("project_ids", id)
+	}
+	for _, g := range r.GroupBy {
+		v.Add("group_by", g)
+	}
+	for _, id := range r.UserIds {
+		v.Add("user_ids", id)
+	}
+	for _, id := range r.ApiKeyIds {
+		v.Add("api_key_ids", id)
+	}
+	for _, m := range r.Models {
+		v.Add("models", m)
+	}
+	if r.Batch != nil {
+		v.Add("batch", strconv.FormatBool(*r.Batch))
+	}
+	if r.Page != nil {
+		v.Add("page", *r.Page)
+	}
+	return v
+}
+
+// UsagePage is a page of time buckets returned by the usage and costs endpoints.
+type UsagePage[T any] struct {
+	Object   string           `json:"object"` // The object type, which is always page.
+	Data     []UsageBucket[T] `json:"data"`
+	HasMore  bool             `json:"has_more"`
+	NextPage *string          `json:"next_page"`
+}
+
+type UsageBucket[T any] struct {
+	Object    string `json:"object"` // The object type, which is always bucket.
+	StartTime int64  `json:"start_time"`
+	EndTime   int64  `json:"end_time"`
+	Results   []T    `json:"results"`
+}
+
+// UsageResult holds the fields of every type of usage result. Metrics and
+// grouping fields that do not apply to a type of usage are nil.
+type UsageResult struct {
+	Object            string  `json:"object"`
+	InputTokens       *int64  `json:"input_tokens"`
+	OutputTokens      *int64  `json:"output_tokens"`
+	InputCachedTokens *int64  `json:"input_cached_tokens"`
+	InputAudioTokens  *int64  `json:"input_audio_tokens"`
+	OutputAudioTokens *int64  `json:"output_audio_tokens"`
+	NumModelRequests  *int64  `json:"num_model_requests"`
+	Images            *int64  `json:"images"`
+	Characters        *int64  `json:"characters"`
+	Seconds           *int64  `json:"seconds"`
+	UsageBytes        *int64  `json:"usage_bytes"`
+	NumSessions       *int64  `json:"num_sessions"`
+	ProjectId         *string `json:"project_id"`
+	UserId            *string `json:"user_id"`
+	ApiKeyId          *string `json:"api_key_id"`
+	Model             *string `json:"model"`
+	Batch             *bool   `json:"batch"`
+	Source            *string `json:"source"`
+	Size              *string `json:"size"`
+}
+
+type CostResult struct {
+	Object string `json:"object"`
+	Amount *struct {
+		// The numeric value of the cost.
+		Value float64 `json:"value"`
+		// Lowercase ISO-4217 currency e.g. "usd".
+		Currency string `json:"currency"`
+	} `json:"amount"`
+	LineItem  *string `json:"line_item"`
+	ProjectId *string `json:"project_id"`
+}
+
+// Get usage details for a type of usage, such as completions or embeddings.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/usage/completions
+func (e *UsageEndpoint) GetUsage(usageType string, req *UsageRequest) (*UsagePage[UsageResult], error) {
+	var page UsagePage[UsageResult]
+	err := e.client.doAdmin("GET", UsageEndpointPath+"/"+url.PathEscape(usageType), nil, req.values(), &page)
+	return &page, err
+}
+
+// Get costs details for the organization.
+// [OpenAI Documentation]: https://platform.openai.com/docs/api-reference/usage/costs
+func (e *UsageEndpoint) GetCosts(req *UsageRequest) (*UsagePage[CostResult], error) {
+	var page UsagePage[CostResult]
+	err := e.client.doAdmin("GET", CostsEndpointPath, nil, req.values(), &page)
+	return &page, err
+}
@@ -2,17 +2,28 @@ package openai
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/skyscrapr/openai-sdk-go/openai"
 )
 
 type OpenAIDatasource struct {
-	client *openai.Client
+	client *OpenAIClient
 }
 
 func (d *OpenAIDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -21,12 +32,12 @@ func (d *OpenAIDatasource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*openai.Client)
+	client, ok := req.ProviderData.(*OpenAIClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Client Type",
-			fmt.Sprintf("Expected *openai.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *OpenAIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -35,7 +46,7 @@ func (d *OpenAIDatasource) Configure(ctx context.Context, req datasource.Configu
 }
 
 type OpenAIResource struct {
-	client *openai.Client
+	client *OpenAIClient
 }
 
 func (d *OpenAIResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -44,12 +55,35 @@ func (d *OpenAIResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*openai.Client)
+	client, ok := req.ProviderData.(*OpenAIClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Client Type",
-			fmt.Sprintf("Expected *openai.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *OpenAIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+type OpenAIEphemeralResource struct {
+	client *OpenAIClient
+}
+
+func (d *OpenAIEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*OpenAIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Client Type",
+			fmt.Sprintf("Expected *OpenAIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -68,8 +102,245 @@ func GetFilePath(filePath string) (*string, error) {
 }
 
 func GetOpenAIAPIError(err error) *openai.APIError {
-	if openaierr, ok := err.(*openai.APIError); ok {
+	var openaierr *openai.APIError
+	if errors.As(err, &openaierr) {
 		return openaierr
 	}
 	return nil
 }
+
+// openAIErrorWrongKeyType is the code given to errors caused by using a
+// project API key where an admin key is needed, which the API reports as
+// missing permissions rather than with a code of their own.
+const openAIErrorWrongKeyType = "wrong_key_type"
+
+// openAIErrorHints are the summaries and remediation text of known API errors, by code.
+var openAIErrorHints = map[string]struct {
+	summary     string
+	remediation string
+}{
+	"insufficient_quota": {
+		summary: "OpenAI Quota Exceeded",
+		remediation: "The organization or project has run out of credits or reached its spend limit. " +
+			"Check the billing settings at https://platform.openai.com/settings/organization/billing, or use a key of a project with remaining quota.",
+	},
+	"invalid_api_key": {
+		summary: "Invalid OpenAI API Key",
+		remediation: "Check the api_key and admin_key of the provider, or the OPENAI_API_KEY and OPENAI_ADMIN_KEY environment variables. " +
+			"The key may have been revoked, or belong to a deleted project or service account.",
+	},
+	"model_not_found": {
+		summary: "OpenAI Model Not Found",
+		remediation: "Check the model name and that the project of the API key has access to the model. " +
+			"The openai_models data source lists the models available to the key.",
+	},
+	"rate_limit_exceeded": {
+		summary: "OpenAI Rate Limit Exceeded",
+		remediation: "Too many requests or tokens were sent in a short time. Retry later, or reduce the number of concurrent requests with terraform apply -parallelism=1. " +
+			"The limits of the organization are at https://platform.openai.com/settings/organization/limits.",
+	},
+	openAIErrorWrongKeyType: {
+		summary: "Wrong OpenAI Key Type",
+		remediation: "Organization endpoints, such as projects, service accounts, admin API keys, usage and audit logs, need an admin key set with admin_key or OPENAI_ADMIN_KEY. " +
+			"Other endpoints need a project API key set with api_key or OPENAI_API_KEY. " +
+			"Admin keys are created at https://platform.openai.com/settings/organization/admin-keys.",
+	},
+}
+
+// openAIErrorParamRegex matches the top-level attribute of an error parameter such as tools[0].function.
+var openAIErrorParamRegex = regexp.MustCompile(`^[a-z_]+`)
+
+// NewOpenAIErrorDiagnostic returns the diagnostic for an error of the OpenAI
+// client, described by detail such as "Unable to create assistant". Known API
+// errors get a summary and remediation text, and are attached to the
+// attribute of the request parameter they are about.
+func NewOpenAIErrorDiagnostic(detail string, err error) diag.Diagnostic {
+	summary := "OpenAI Client Error"
+	detail = fmt.Sprintf("%s, got error: %s", detail, err)
+
+	apiError := GetOpenAIAPIError(err)
+	if apiError == nil {
+		return diag.NewErrorDiagnostic(summary, detail)
+	}
+	code := openAIErrorCode(apiError)
+	if hint, ok := openAIErrorHints[code]; ok {
+		summary = hint.summary
+		detail = fmt.Sprintf("%s\n\n%s", detail, hint.remediation)
+	}
+
+	param := ""
+	if apiError.Param != nil {
+		param = openAIErrorParamRegex.FindString(*apiError.Param)
+	}
+	if param == "" && code == "model_not_found" {
+		param = "model"
+	}
+	if param != "" {
+		return diag.NewAttributeErrorDiagnostic(path.Root(param), summary, detail)
+	}
+	return diag.NewErrorDiagnostic(summary, detail)
+}
+
+// openAIErrorCode returns the code of an API error, falling back to its type.
+func openAIErrorCode(apiError *openai.APIError) string {
+	if apiError.HTTPStatusCode == http.StatusUnauthorized || apiError.HTTPStatusCode == http.StatusForbidden {
+		message := strings.ToLower(apiError.Message)
+		for _, s := range []string{"missing scopes", "admin key", "admin api key"} {
+			if strings.Contains(message, s) {
+				return openAIErrorWrongKeyType
+			}
+		}
+	}
+	if code, ok := apiError.Code.(string); ok && code != "" {
+		return code
+	}
+	return apiError.Type
+}
+
+// attrValueToJSON converts a Terraform value, such as the contents of a dynamic
+// attribute, into a value that can be encoded with encoding/json.
+func attrValueToJSON(v attr.Value) (interface{}, error) {
+	if v.IsUnknown() {
+		return nil, fmt.Errorf("value is not known until apply")
+	}
+	if v.IsNull() {
+		return nil, nil
+	}
+	switch val := v.(type) {
+	case types.Dynamic:
+		return attrValueToJSON(val.UnderlyingValue())
+	case types.String:
+		return val.ValueString(), nil
+	case types.Bool:
+		return val.ValueBool(), nil
+	case types.Int64:
+		return val.ValueInt64(), nil
+	case types.Float64:
+		return val.ValueFloat64(), nil
+	case types.Number:
+		return json.Number(val.ValueBigFloat().Text('f', -1)), nil
+	case types.List:
+		return attrValuesToJSON(val.Elements())
+	case types.Set:
+		return attrValuesToJSON(val.Elements())
+	case types.Tuple:
+		return attrValuesToJSON(val.Elements())
+	case types.Map:
+		return attrValueMapToJSON(val.Elements())
+	case types.Object:
+		return attrValueMapToJSON(val.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+func attrValuesToJSON(elements []attr.Value) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(elements))
+	for _, e := range elements {
+		v, err := attrValueToJSON(e)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func attrValueMapToJSON(elements map[string]attr.Value) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(elements))
+	for k, e := range elements {
+		v, err := attrValueToJSON(e)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+// jsonToAttrValue converts a value decoded with json.Decoder.UseNumber into a
+// Terraform value for a dynamic attribute. Objects become object values and
+// arrays become tuples, so elements keep their own types. JSON null becomes a
+// null string, since a dynamic value cannot hold an untyped null.
+func jsonToAttrValue(v interface{}) (attr.Value, error) {
+	switch val := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(val), nil
+	case bool:
+		return types.BoolValue(val), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(val.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(f), nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, len(val))
+		elems := make([]attr.Value, len(val))
+		for i, e := range val {
+			elem, err := jsonToAttrValue(e)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes[i] = elem.Type(context.Background())
+			elems[i] = elem
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to build tuple: %v", diags)
+		}
+		return tuple, nil
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(val))
+		attrs := make(map[string]attr.Value, len(val))
+		for k, e := range val {
+			a, err := jsonToAttrValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attrTypes[k] = a.Type(context.Background())
+			attrs[k] = a
+		}
+		obj, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to build object: %v", diags)
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// decodeJSONToDynamic decodes a JSON document into a dynamic value.
+func decodeJSONToDynamic(s string) (types.Dynamic, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return types.DynamicNull(), err
+	}
+	if decoder.More() {
+		return types.DynamicNull(), fmt.Errorf("unexpected data after the JSON document")
+	}
+	value, err := jsonToAttrValue(v)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+	return types.DynamicValue(value), nil
+}
+
+// reasoningModelPrefixes are the model families that accept reasoning_effort
+// and reject the temperature and top_p sampling parameters.
+var reasoningModelPrefixes = []string{"o1", "o3", "o4", "gpt-5"}
+
+// isReasoningModel reports whether the model belongs to a reasoning model family.
+func isReasoningModel(model string) bool {
+	for _, prefix := range reasoningModelPrefixes {
+		if model == prefix || strings.HasPrefix(model, prefix+"-") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package openai
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/skyscrapr/openai-sdk-go/openai"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOpenAIAPIError_Wrapped(t *testing.T) {
+	apiError := &openai.APIError{Message: "No such File object: file-abc", HTTPStatusCode: http.StatusNotFound}
+
+	assert.Equal(t, apiError, GetOpenAIAPIError(fmt.Errorf("unable to read file: %w", apiError)))
+	assert.Nil(t, GetOpenAIAPIError(errors.New("connection refused")))
+}
+
+func TestNewOpenAIErrorDiagnostic(t *testing.T) {
+	param := func(s string) *string { return &s }
+
+	for name, tc := range map[string]struct {
+		err     error
+		summary string
+		path    path.Path
+		hint    string
+	}{
+		"not an api error": {
+			err:     errors.New("connection refused"),
+			summary: "OpenAI Client Error",
+		},
+		"unknown code": {
+			err:     &openai.APIError{Message: "Invalid value", Type: "invalid_request_error", Code: "invalid_value", Param: param("temperature"), HTTPStatusCode: http.StatusBadRequest},
+			summary: "OpenAI Client Error",
+			path:    path.Root("temperature"),
+		},
+		"insufficient quota": {
+			err:     &openai.APIError{Message: "You exceeded your current quota", Type: "insufficient_quota", Code: "insufficient_quota", HTTPStatusCode: http.StatusTooManyRequests},
+			summary: "OpenAI Quota Exceeded",
+			hint:    "billing settings",
+		},
+		"invalid api key": {
+			err:     &openai.APIError{Message: "Incorrect API key provided", Type: "invalid_request_error", Code: "invalid_api_key", HTTPStatusCode: http.StatusUnauthorized},
+			summary: "Invalid OpenAI API Key",
+			hint:    "OPENAI_API_KEY",
+		},
+		"model not found": {
+			err:     &openai.APIError{Message: "The model `gpt-9` does not exist", Type: "invalid_request_error", Code: "model_not_found", HTTPStatusCode: http.StatusNotFound},
+			summary: "OpenAI Model Not Found",
+			path:    path.Root("model"),
+			hint:    "openai_models",
+		},
+		"rate limit exceeded": {
+			err:     &openai.APIError{Message: "Rate limit reached", Type: "requests", Code: "rate_limit_exceeded", HTTPStatusCode: http.StatusTooManyRequests},
+			summary: "OpenAI Rate Limit Exceeded",
+			hint:    "-parallelism=1",
+		},
+		"wrong key type": {
+			err:     &openai.APIError{Message: "You have insufficient permissions for this operation. Missing scopes: api.management.read.", Type: "invalid_request_error", HTTPStatusCode: http.StatusUnauthorized},
+			summary: "Wrong OpenAI Key Type",
+			hint:    "OPENAI_ADMIN_KEY",
+		},
+		"nested param": {
+			err:     &openai.APIError{Message: "Invalid schema", Type: "invalid_request_error", Param: param("tools[0].function.parameters"), HTTPStatusCode: http.StatusBadRequest},
+			summary: "OpenAI Client Error",
+			path:    path.Root("tools"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := NewOpenAIErrorDiagnostic("Unable to create assistant", tc.err)
+
+			assert.Equal(t, diag.SeverityError, d.Severity())
+			assert.Equal(t, tc.summary, d.Summary())
+			assert.Contains(t, d.Detail(), fmt.Sprintf("Unable to create assistant, got error: %s", tc.err))
+			assert.Contains(t, d.Detail(), tc.hint)
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			assert.Equal(t, len(tc.path.Steps()) > 0, ok)
+			if ok {
+				assert.Equal(t, tc.path, withPath.Path())
+			}
+		})
+	}
+}
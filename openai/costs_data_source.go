@@ -0,0 +1,170 @@
+package openai
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CostsDataSource{}
+
+func NewCostsDataSource() datasource.DataSource {
+	return &CostsDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// CostsDataSource defines the data source implementation.
+type CostsDataSource struct {
+	*OpenAIDatasource
+}
+
+// CostsDataSourceModel describes the data source data model.
+type CostsDataSourceModel struct {
+	Id          types.String       `tfsdk:"id"`
+	StartTime   timetypes.RFC3339  `tfsdk:"start_time"`
+	EndTime     timetypes.RFC3339  `tfsdk:"end_time"`
+	ProjectIds  types.List         `tfsdk:"project_ids"`
+	BucketWidth types.String       `tfsdk:"bucket_width"`
+	GroupBy     types.List         `tfsdk:"group_by"`
+	Buckets     []CostsBucketModel `tfsdk:"buckets"`
+	TotalAmount types.Float64      `tfsdk:"total_amount"`
+	Currency    types.String       `tfsdk:"currency"`
+}
+
+type CostsBucketModel struct {
+	StartTime timetypes.RFC3339  `tfsdk:"start_time"`
+	EndTime   timetypes.RFC3339  `tfsdk:"end_time"`
+	Results   []CostsResultModel `tfsdk:"results"`
+}
+
+type CostsResultModel struct {
+	Amount    types.Float64 `tfsdk:"amount"`
+	Currency  types.String  `tfsdk:"currency"`
+	LineItem  types.String  `tfsdk:"line_item"`
+	ProjectId types.String  `tfsdk:"project_id"`
+}
+
+func (d *CostsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_costs"
+}
+
+func (d *CostsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := usageWindowAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Costs identifier",
+		Computed:            true,
+	}
+	attributes["bucket_width"] = schema.StringAttribute{
+		MarkdownDescription: "Width of each time bucket in response. Currently only 1d is supported, which is the default.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("1d"),
+		},
+	}
+	attributes["group_by"] = schema.ListAttribute{
+		MarkdownDescription: "Group the costs by the specified fields: `project_id`, `line_item`.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(stringvalidator.OneOf("project_id", "line_item")),
+		},
+	}
+	attributes["buckets"] = usageBucketsAttribute(map[string]schema.Attribute{
+		"amount": schema.Float64Attribute{
+			MarkdownDescription: "The numeric value of the cost.",
+			Computed:            true,
+		},
+		"currency": schema.StringAttribute{
+			MarkdownDescription: "Lowercase ISO-4217 currency e.g. usd.",
+			Computed:            true,
+		},
+		"line_item": schema.StringAttribute{
+			MarkdownDescription: "The line item, when grouped by line_item.",
+			Computed:            true,
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "The project ID, when grouped by project_id.",
+			Computed:            true,
+		},
+	})
+	attributes["total_amount"] = schema.Float64Attribute{
+		MarkdownDescription: "The costs summed over all buckets and results.",
+		Computed:            true,
+	}
+	attributes["currency"] = schema.StringAttribute{
+		MarkdownDescription: "The currency of total_amount. Null when there are no costs.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Costs data source. Returns the costs of the organization in daily buckets, optionally grouped by project and line item. Requires an admin key.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *CostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CostsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	uReq, diags := readUsageRequest(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Buckets = []CostsBucketModel{}
+	data.Currency = types.StringNull()
+	total := 0.0
+	for {
+		tflog.Debug(ctx, "Reading costs page")
+		page, err := d.client.WithContext(ctx).Usage().GetCosts(uReq)
+		if err != nil {
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read costs", err))
+			return
+		}
+		for _, b := range page.Data {
+			bucket := CostsBucketModel{
+				StartTime: timetypes.NewRFC3339TimeValue(time.Unix(b.StartTime, 0).UTC()),
+				EndTime:   timetypes.NewRFC3339TimeValue(time.Unix(b.EndTime, 0).UTC()),
+				Results:   []CostsResultModel{},
+			}
+			for _, r := range b.Results {
+				result := CostsResultModel{
+					Amount:    types.Float64Null(),
+					Currency:  types.StringNull(),
+					LineItem:  types.StringPointerValue(r.LineItem),
+					ProjectId: types.StringPointerValue(r.ProjectId),
+				}
+				if r.Amount != nil {
+					result.Amount = types.Float64Value(r.Amount.Value)
+					result.Currency = types.StringValue(r.Amount.Currency)
+					data.Currency = result.Currency
+					total += r.Amount.Value
+				}
+				bucket.Results = append(bucket.Results, result)
+			}
+			data.Buckets = append(data.Buckets, bucket)
+		}
+		if !page.HasMore || page.NextPage == nil {
+			break
+		}
+		uReq.Page = page.NextPage
+	}
+	data.TotalAmount = types.Float64Value(total)
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,126 @@
+package openai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/tiktoken-go/tokenizer"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CountTokensFunction{}
+
+func NewCountTokensFunction() function.Function {
+	return &CountTokensFunction{}
+}
+
+// CountTokensFunction defines the function implementation.
+type CountTokensFunction struct{}
+
+func (f *CountTokensFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "count_tokens"
+}
+
+func (f *CountTokensFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Count the tokens in a text",
+		MarkdownDescription: "Counts the tokens in a text with the tokenizer encoding used by a model. " +
+			"The encodings are bundled with the provider, so no API calls are made. " +
+			"The count is for the text alone, messages and tools add a few tokens of overhead per request.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "model",
+				MarkdownDescription: "The model, e.g. `gpt-4o`, or the name of an encoding, e.g. `o200k_base` or `cl100k_base`.",
+			},
+			function.StringParameter{
+				Name:                "text",
+				MarkdownDescription: "The text to count the tokens of.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *CountTokensFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var model, text string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &model, &text))
+	if resp.Error != nil {
+		return
+	}
+
+	codec, err := tokenizerForModel(model)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	count, err := codec.Count(text)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to count tokens, got error: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, int64(count)))
+}
+
+// modelPrefixEncodings maps models the tokenizer package does not know yet to their encodings.
+var modelPrefixEncodings = []struct {
+	prefix   string
+	encoding tokenizer.Encoding
+}{
+	{"gpt-5", tokenizer.O200kBase},
+	{"gpt-4.1", tokenizer.O200kBase},
+	{"gpt-4.5", tokenizer.O200kBase},
+	{"gpt-4o", tokenizer.O200kBase},
+	{"gpt-oss", tokenizer.O200kBase},
+	{"chatgpt-", tokenizer.O200kBase},
+	{"o1", tokenizer.O200kBase},
+	{"o3", tokenizer.O200kBase},
+	{"o4", tokenizer.O200kBase},
+	{"text-embedding-3", tokenizer.Cl100kBase},
+	{"gpt-4", tokenizer.Cl100kBase},
+	{"gpt-3.5", tokenizer.Cl100kBase},
+}
+
+var tokenizerCodecs sync.Map
+
+// tokenizerForModel returns the tokenizer for a model or an encoding name.
+// Codecs are cached as loading the vocabularies is expensive.
+func tokenizerForModel(model string) (tokenizer.Codec, error) {
+	encoding := tokenizer.Encoding(model)
+	switch encoding {
+	case tokenizer.O200kBase, tokenizer.Cl100kBase, tokenizer.P50kBase, tokenizer.P50kEdit, tokenizer.R50kBase:
+	default:
+		encoding = ""
+		// Fine-tuned models use the encoding of their base model.
+		base := strings.TrimPrefix(model, "ft:")
+		codec, err := tokenizer.ForModel(tokenizer.Model(base))
+		if err == nil {
+			encoding = tokenizer.Encoding(codec.GetName())
+		} else if !errors.Is(err, tokenizer.ErrModelNotSupported) {
+			return nil, err
+		}
+		for _, p := range modelPrefixEncodings {
+			if encoding == "" && strings.HasPrefix(base, p.prefix) {
+				encoding = p.encoding
+			}
+		}
+		if encoding == "" {
+			return nil, fmt.Errorf("no tokenizer encoding is known for model %q, use an encoding name such as o200k_base instead", model)
+		}
+	}
+
+	if codec, ok := tokenizerCodecs.Load(encoding); ok {
+		return codec.(tokenizer.Codec), nil
+	}
+	codec, err := tokenizer.Get(encoding)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := tokenizerCodecs.LoadOrStore(encoding, codec)
+	return actual.(tokenizer.Codec), nil
+}
@@ -0,0 +1,49 @@
+package openai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runTestFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) function.RunResponse {
+	t.Helper()
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp
+}
+
+func TestCountTokensFunction_Run(t *testing.T) {
+	tests := []struct {
+		model string
+		text  string
+		want  int64
+	}{
+		{"gpt-4o", "hello world", 2},
+		{"gpt-4o-mini-2024-07-18", "hello world", 2},
+		{"gpt-5", "hello world", 2},
+		{"ft:gpt-4o-mini-2024-07-18:acme::abc123", "hello world", 2},
+		{"gpt-3.5-turbo", "hello world", 2},
+		{"cl100k_base", "", 0},
+		{"o200k_base", "The quick brown fox jumps over the lazy dog.", 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			resp := runTestFunction(t, NewCountTokensFunction(), types.Int64Unknown(), types.StringValue(tt.model), types.StringValue(tt.text))
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, types.Int64Value(tt.want), resp.Result.Value())
+		})
+	}
+}
+
+func TestCountTokensFunction_UnknownModel(t *testing.T) {
+	resp := runTestFunction(t, NewCountTokensFunction(), types.Int64Unknown(), types.StringValue("dall-e-3"), types.StringValue("hello world"))
+	if assert.NotNil(t, resp.Error) {
+		assert.Contains(t, resp.Error.Error(), "dall-e-3")
+		assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+	}
+}
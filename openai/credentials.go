@@ -0,0 +1,127 @@
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// defaultCredentialsProfile is the profile read from the credentials file when none is set.
+const defaultCredentialsProfile = "default"
+
+// credentialsFilePath returns the path of the credentials file, which is
+// ~/.openai/credentials unless set with OPENAI_CREDENTIALS_FILE.
+func credentialsFilePath() string {
+	if p := os.Getenv("OPENAI_CREDENTIALS_FILE"); p != "" {
+		return expandHomeDir(p)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".openai", "credentials")
+}
+
+// loadCredentialsProfile reads a profile from an INI style credentials file:
+//
+//	[default]
+//	api_key = sk-...
+//	admin_key = sk-admin-...
+//
+// It returns nil when the file or the profile does not exist.
+func loadCredentialsProfile(path string, profile string) (map[string]string, error) {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read credentials file %s: %w", path, err)
+	}
+
+	var values map[string]string
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == profile && values == nil {
+				values = map[string]string{}
+			}
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("unable to parse credentials file %s, line %d is not a key = value pair", path, n)
+			}
+			if section == profile {
+				values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+		}
+	}
+	return values, scanner.Err()
+}
+
+// readKeyFile returns the key stored in a file, without surrounding whitespace.
+func readKeyFile(path string) (string, error) {
+	b, err := os.ReadFile(expandHomeDir(path))
+	if err != nil {
+		return "", fmt.Errorf("unable to read key file: %w", err)
+	}
+	key := strings.TrimSpace(string(b))
+	if key == "" {
+		return "", fmt.Errorf("key file %s is empty", path)
+	}
+	return key, nil
+}
+
+// keyCommandCache holds the keys printed by commands, so that a command such
+// as a password manager prompt runs once per provider process rather than
+// every time the provider is configured.
+var keyCommandCache sync.Map
+
+// runKeyCommand runs a command, given as the program and its arguments, and
+// returns the key it prints to stdout.
+func runKeyCommand(ctx context.Context, args []string) (string, error) {
+	if len(args) == 0 {
+		return "", fmt.Errorf("key command is empty")
+	}
+	cacheKey := strings.Join(args, "\x00")
+	if key, ok := keyCommandCache.Load(cacheKey); ok {
+		return key.(string), nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("key command %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	key := strings.TrimSpace(stdout.String())
+	if key == "" {
+		return "", fmt.Errorf("key command %s printed no key", args[0])
+	}
+
+	keyCommandCache.Store(cacheKey, key)
+	return key, nil
+}
+
+// expandHomeDir replaces a leading ~ in a path with the home directory.
+func expandHomeDir(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
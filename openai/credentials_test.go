@@ -0,0 +1,44 @@
+package openai
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCredentialsProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+
+	profile, err := loadCredentialsProfile(path, "default")
+	assert.NoError(t, err)
+	assert.Nil(t, profile, "a missing file has no profiles")
+
+	assert.NoError(t, os.WriteFile(path, []byte("; comment\n[ci]\napi_key = sk-ci=with-equals\n[empty]\n"), 0o600))
+	profile, err = loadCredentialsProfile(path, "ci")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"api_key": "sk-ci=with-equals"}, profile)
+
+	profile, err = loadCredentialsProfile(path, "empty")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{}, profile)
+
+	assert.NoError(t, os.WriteFile(path, []byte("[ci]\napi_key\n"), 0o600))
+	_, err = loadCredentialsProfile(path, "ci")
+	assert.ErrorContains(t, err, "line 2 is not a key = value pair")
+}
+
+func TestReadKeyFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	assert.NoError(t, os.WriteFile(filepath.Join(home, "key"), []byte("  sk-abc\n"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(home, "empty"), []byte("\n"), 0o600))
+
+	key, err := readKeyFile("~/key")
+	assert.NoError(t, err)
+	assert.Equal(t, "sk-abc", key)
+
+	_, err = readKeyFile("~/empty")
+	assert.ErrorContains(t, err, "is empty")
+}
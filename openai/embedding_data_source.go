@@ -0,0 +1,252 @@
+package openai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EmbeddingDataSource{}
+
+// defaultMaxEmbeddingValues caps the number of embedding values stored in
+// state, which is about 65 vectors of text-embedding-3-small.
+const defaultMaxEmbeddingValues = 100000
+
+func NewEmbeddingDataSource() datasource.DataSource {
+	return &EmbeddingDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// EmbeddingDataSource defines the data source implementation.
+type EmbeddingDataSource struct {
+	*OpenAIDatasource
+}
+
+// EmbeddingDataSourceModel describes the data source data model.
+type EmbeddingDataSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Model            types.String `tfsdk:"model"`
+	Input            types.List   `tfsdk:"input"`
+	Dimensions       types.Int64  `tfsdk:"dimensions"`
+	EncodingFormat   types.String `tfsdk:"encoding_format"`
+	MaxValues        types.Int64  `tfsdk:"max_values"`
+	Embeddings       types.List   `tfsdk:"embeddings"`
+	EmbeddingsBase64 types.List   `tfsdk:"embeddings_base64"`
+	Usage            types.Object `tfsdk:"usage"`
+}
+
+type EmbeddingUsageModel struct {
+	PromptTokens types.Int64 `tfsdk:"prompt_tokens"`
+	TotalTokens  types.Int64 `tfsdk:"total_tokens"`
+}
+
+func (e EmbeddingUsageModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"prompt_tokens": types.Int64Type,
+		"total_tokens":  types.Int64Type,
+	}
+}
+
+func (d *EmbeddingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embedding"
+}
+
+func (d *EmbeddingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Embedding data source. Creates embedding vectors for a small, fixed list of inputs such as routing intents or category labels. " +
+			"The vectors are stored in state, so the total number of values is limited by `max_values`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Embedding identifier. This is the sha256 of the model, dimensions, encoding format and input.",
+				Computed:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "ID of the model to use, for example text-embedding-3-small.",
+				Required:            true,
+			},
+			"input": schema.ListAttribute{
+				MarkdownDescription: "The texts to embed. The embeddings are returned in the same order.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2048),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"dimensions": schema.Int64Attribute{
+				MarkdownDescription: "The number of dimensions the resulting output embeddings should have. Only supported in text-embedding-3 and later models.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"encoding_format": schema.StringAttribute{
+				MarkdownDescription: "The format to return the embeddings in: float (the default) returns `embeddings`, base64 returns `embeddings_base64` which is much smaller in state.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("float", "base64"),
+				},
+			},
+			"max_values": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of embedding values, across all inputs, to store in state. Defaults to %d.", defaultMaxEmbeddingValues),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"embeddings": schema.ListAttribute{
+				MarkdownDescription: "The embedding vectors, one per input, when encoding_format is float.",
+				ElementType:         types.ListType{ElemType: types.Float64Type},
+				Computed:            true,
+			},
+			"embeddings_base64": schema.ListAttribute{
+				MarkdownDescription: "The base64 encoded little-endian float32 embedding vectors, one per input, when encoding_format is base64.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"usage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Usage statistics for the request.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"prompt_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Number of tokens in the input.",
+						Computed:            true,
+					},
+					"total_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Total number of tokens used in the request.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EmbeddingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EmbeddingDataSourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eReq := EmbeddingsRequest{
+		Model:          data.Model.ValueString(),
+		Dimensions:     data.Dimensions.ValueInt64Pointer(),
+		EncodingFormat: data.EncodingFormat.ValueStringPointer(),
+	}
+	resp.Diagnostics.Append(data.Input.ElementsAs(ctx, &eReq.Input, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxValues := int64(defaultMaxEmbeddingValues)
+	if !data.MaxValues.IsNull() {
+		maxValues = data.MaxValues.ValueInt64()
+	}
+	// Fail before calling the API when the size is already known to be too large.
+	if eReq.Dimensions != nil && int64(len(eReq.Input))*(*eReq.Dimensions) > maxValues {
+		resp.Diagnostics.AddAttributeError(path.Root("max_values"), "Embeddings Too Large", fmt.Sprintf("%d inputs of %d dimensions exceed max_values (%d). Reduce the input or dimensions, or raise max_values.", len(eReq.Input), *eReq.Dimensions, maxValues))
+		return
+	}
+
+	tflog.Info(ctx, "Creating Embeddings...")
+	embeddings, err := d.client.WithContext(ctx).Embeddings().CreateEmbeddings(&eReq)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create embeddings", err))
+		return
+	}
+	if len(embeddings.Data) != len(eReq.Input) {
+		resp.Diagnostics.AddError("Unexpected Embeddings Response", fmt.Sprintf("Unable to create embeddings, got %d embeddings for %d inputs", len(embeddings.Data), len(eReq.Input)))
+		return
+	}
+	sort.Slice(embeddings.Data, func(i, j int) bool { return embeddings.Data[i].Index < embeddings.Data[j].Index })
+
+	base64Format := data.EncodingFormat.ValueString() == "base64"
+	var vectors [][]float64
+	var encoded []string
+	var values int64
+	for _, e := range embeddings.Data {
+		if base64Format {
+			var s string
+			if err := json.Unmarshal(e.Embedding, &s); err != nil {
+				resp.Diagnostics.AddError("Invalid Embedding", fmt.Sprintf("Unable to decode embedding %d, got error: %s", e.Index, err))
+				return
+			}
+			b, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid Embedding", fmt.Sprintf("Unable to decode embedding %d, got error: %s", e.Index, err))
+				return
+			}
+			encoded = append(encoded, s)
+			values += int64(len(b) / 4)
+		} else {
+			var v []float64
+			if err := json.Unmarshal(e.Embedding, &v); err != nil {
+				resp.Diagnostics.AddError("Invalid Embedding", fmt.Sprintf("Unable to decode embedding %d, got error: %s", e.Index, err))
+				return
+			}
+			vectors = append(vectors, v)
+			values += int64(len(v))
+		}
+	}
+	if values > maxValues {
+		resp.Diagnostics.AddAttributeError(path.Root("max_values"), "Embeddings Too Large", fmt.Sprintf("The embeddings contain %d values, which exceeds max_values (%d). Reduce the input or dimensions, or raise max_values.", values, maxValues))
+		return
+	}
+
+	data.Embeddings = types.ListNull(types.ListType{ElemType: types.Float64Type})
+	data.EmbeddingsBase64 = types.ListNull(types.StringType)
+	if base64Format {
+		data.EmbeddingsBase64, diags = types.ListValueFrom(ctx, types.StringType, encoded)
+	} else {
+		data.Embeddings, diags = types.ListValueFrom(ctx, types.ListType{ElemType: types.Float64Type}, vectors)
+	}
+	resp.Diagnostics.Append(diags...)
+
+	if embeddings.Usage == nil {
+		data.Usage = types.ObjectNull(EmbeddingUsageModel{}.AttrTypes())
+	} else {
+		usage := EmbeddingUsageModel{
+			PromptTokens: types.Int64Value(embeddings.Usage.PromptTokens),
+			TotalTokens:  types.Int64Value(embeddings.Usage.TotalTokens),
+		}
+		data.Usage, diags = types.ObjectValueFrom(ctx, EmbeddingUsageModel{}.AttrTypes(), usage)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := json.Marshal([]interface{}{eReq.Model, eReq.Dimensions, eReq.EncodingFormat, eReq.Input})
+	if err != nil {
+		resp.Diagnostics.AddError("Embedding Error", fmt.Sprintf("Unable to compute id, got error: %s", err))
+		return
+	}
+	sum := sha256.Sum256(id)
+	data.Id = types.StringValue(hex.EncodeToString(sum[:]))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,142 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccEmbeddingDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccEmbeddingDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openai_embedding.test", "id"),
+					resource.TestCheckResourceAttr("data.openai_embedding.test", "embeddings.#", "2"),
+					resource.TestCheckResourceAttr("data.openai_embedding.test", "embeddings.0.#", "8"),
+					resource.TestCheckResourceAttrSet("data.openai_embedding.test", "usage.total_tokens"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEmbeddingDataSourceConfig = `
+data "openai_embedding" "test" {
+	model = "text-embedding-3-small"
+	input = ["billing question", "technical support"]
+	dimensions = 8
+}
+`
+
+func newTestEmbeddingConfig(encodingFormat types.String, maxValues types.Int64) EmbeddingDataSourceModel {
+	return EmbeddingDataSourceModel{
+		Id:               types.StringNull(),
+		Model:            types.StringValue("text-embedding-3-small"),
+		Input:            types.ListValueMust(types.StringType, []attr.Value{types.StringValue("billing"), types.StringValue("support")}),
+		Dimensions:       types.Int64Null(),
+		EncodingFormat:   encodingFormat,
+		MaxValues:        maxValues,
+		Embeddings:       types.ListNull(types.ListType{ElemType: types.Float64Type}),
+		EmbeddingsBase64: types.ListNull(types.StringType),
+		Usage:            types.ObjectNull(EmbeddingUsageModel{}.AttrTypes()),
+	}
+}
+
+func TestEmbeddingDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	// The embeddings are returned out of order to check they are sorted by index.
+	client, recorder := newRecordingTestClient(t, `{
+		"object": "list",
+		"model": "text-embedding-3-small",
+		"data": [
+			{"object": "embedding", "index": 1, "embedding": [0.5, -0.5]},
+			{"object": "embedding", "index": 0, "embedding": [0.25, 0.75]}
+		],
+		"usage": {"prompt_tokens": 4, "total_tokens": 4}
+	}`)
+	d := &EmbeddingDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	config := newTestEmbeddingConfig(types.StringNull(), types.Int64Null())
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	req := recorder.last(t)
+	assert.Equal(t, "/v1/embeddings", req.Path)
+	assert.Equal(t, []interface{}{"billing", "support"}, req.Body["input"])
+	assert.NotContains(t, req.Body, "dimensions")
+
+	var state EmbeddingDataSourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	var vectors [][]float64
+	assert.False(t, state.Embeddings.ElementsAs(ctx, &vectors, false).HasError())
+	assert.Equal(t, [][]float64{{0.25, 0.75}, {0.5, -0.5}}, vectors)
+	assert.True(t, state.EmbeddingsBase64.IsNull())
+	assert.NotEmpty(t, state.Id.ValueString())
+}
+
+func TestEmbeddingDataSource_ReadBase64(t *testing.T) {
+	ctx := context.Background()
+	// Each vector holds two float32 values.
+	client, _ := newRecordingTestClient(t, `{
+		"object": "list",
+		"data": [
+			{"object": "embedding", "index": 0, "embedding": "AACAPwAAAEA="},
+			{"object": "embedding", "index": 1, "embedding": "AABAQAAAgEA="}
+		]
+	}`)
+	d := &EmbeddingDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	config := newTestEmbeddingConfig(types.StringValue("base64"), types.Int64Null())
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state EmbeddingDataSourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.True(t, state.Embeddings.IsNull())
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("AACAPwAAAEA="), types.StringValue("AABAQAAAgEA=")}), state.EmbeddingsBase64)
+	assert.True(t, state.Usage.IsNull())
+}
+
+func TestEmbeddingDataSource_SizeGuard(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newRecordingTestClient(t, `{
+		"object": "list",
+		"data": [
+			{"object": "embedding", "index": 0, "embedding": [0.1, 0.2, 0.3]},
+			{"object": "embedding", "index": 1, "embedding": [0.4, 0.5, 0.6]}
+		]
+	}`)
+	d := &EmbeddingDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	config := newTestEmbeddingConfig(types.StringNull(), types.Int64Value(5))
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Embeddings Too Large", resp.Diagnostics.Errors()[0].Summary())
+	assert.True(t, resp.State.Raw.IsNull())
+
+	// With known dimensions the API is not called at all.
+	called := false
+	d.client = newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) { called = true })
+	config.Dimensions = types.Int64Value(3)
+	resp = datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.False(t, called)
+}
@@ -0,0 +1,116 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &EstimateCostFunction{}
+
+func NewEstimateCostFunction() function.Function {
+	return &EstimateCostFunction{}
+}
+
+// EstimateCostFunction defines the function implementation.
+type EstimateCostFunction struct{}
+
+// modelPrice is the standard price of a model in USD per million tokens.
+type modelPrice struct {
+	input  float64
+	output float64
+}
+
+// modelPrices are the standard prices of text models. Dated snapshots are
+// priced as the model they start with, the longest matching name wins.
+var modelPrices = map[string]modelPrice{
+	"gpt-5":                  {1.25, 10.00},
+	"gpt-5-mini":             {0.25, 2.00},
+	"gpt-5-nano":             {0.05, 0.40},
+	"gpt-4.1":                {2.00, 8.00},
+	"gpt-4.1-mini":           {0.40, 1.60},
+	"gpt-4.1-nano":           {0.10, 0.40},
+	"gpt-4o":                 {2.50, 10.00},
+	"gpt-4o-mini":            {0.15, 0.60},
+	"gpt-4-turbo":            {10.00, 30.00},
+	"gpt-4":                  {30.00, 60.00},
+	"gpt-3.5-turbo":          {0.50, 1.50},
+	"o1":                     {15.00, 60.00},
+	"o1-mini":                {1.10, 4.40},
+	"o3":                     {2.00, 8.00},
+	"o3-mini":                {1.10, 4.40},
+	"o4-mini":                {1.10, 4.40},
+	"text-embedding-3-small": {0.02, 0},
+	"text-embedding-3-large": {0.13, 0},
+	"text-embedding-ada-002": {0.10, 0},
+}
+
+func (f *EstimateCostFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "estimate_cost"
+}
+
+func (f *EstimateCostFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Estimate the cost of a request",
+		MarkdownDescription: "Estimates the cost in USD of a request to a model from its input and output tokens, " +
+			"using the standard prices bundled with the provider. Cached inputs, batch and flex processing are cheaper, " +
+			"so the estimate is an upper bound for them. Prices change over time, so check the estimate against the OpenAI pricing page.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "model",
+				MarkdownDescription: "The model, e.g. `gpt-4o-mini` or `gpt-4o-mini-2024-07-18`.",
+			},
+			function.Int64Parameter{
+				Name:                "input_tokens",
+				MarkdownDescription: "The number of input tokens, e.g. from `count_tokens`.",
+			},
+			function.Int64Parameter{
+				Name:                "output_tokens",
+				MarkdownDescription: "The number of output tokens.",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *EstimateCostFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var model string
+	var inputTokens, outputTokens int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &model, &inputTokens, &outputTokens))
+	if resp.Error != nil {
+		return
+	}
+
+	price, ok := priceForModel(model)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("No price is known for model %q", model))
+		return
+	}
+	if inputTokens < 0 {
+		resp.Error = function.NewArgumentFuncError(1, "input_tokens must not be negative")
+		return
+	}
+	if outputTokens < 0 {
+		resp.Error = function.NewArgumentFuncError(2, "output_tokens must not be negative")
+		return
+	}
+
+	cost := (float64(inputTokens)*price.input + float64(outputTokens)*price.output) / 1e6
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, cost))
+}
+
+// priceForModel returns the price of the longest model name the model starts with.
+func priceForModel(model string) (modelPrice, bool) {
+	var match string
+	for name := range modelPrices {
+		if (model == name || strings.HasPrefix(model, name+"-")) && len(name) > len(match) {
+			match = name
+		}
+	}
+	price, ok := modelPrices[match]
+	return price, ok
+}
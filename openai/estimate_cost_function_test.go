@@ -0,0 +1,56 @@
+package openai
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateCostFunction_Run(t *testing.T) {
+	tests := []struct {
+		model string
+		input int64
+		out   int64
+		want  float64
+	}{
+		{"gpt-4o", 1_000_000, 1_000_000, 12.50},
+		{"gpt-4o-mini", 1_000_000, 0, 0.15},
+		{"gpt-4o-mini-2024-07-18", 2_000_000, 1_000_000, 0.90},
+		{"gpt-4.1-nano", 0, 1_000_000, 0.40},
+		{"gpt-4-turbo-2024-04-09", 1_000, 1_000, 0.04},
+		{"o3-mini", 1_000_000, 1_000_000, 5.50},
+		{"text-embedding-3-small", 500_000, 0, 0.01},
+	}
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			resp := runTestFunction(t, NewEstimateCostFunction(), types.Float64Unknown(),
+				types.StringValue(tt.model), types.Int64Value(tt.input), types.Int64Value(tt.out))
+			assert.Nil(t, resp.Error)
+			assert.InDelta(t, tt.want, resp.Result.Value().(types.Float64).ValueFloat64(), 1e-9)
+		})
+	}
+}
+
+func TestEstimateCostFunction_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    string
+		input    int64
+		out      int64
+		argument int64
+	}{
+		{"unknown model", "gpt-4oo", 1, 1, 0},
+		{"negative input", "gpt-4o", -1, 1, 1},
+		{"negative output", "gpt-4o", 1, -1, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := runTestFunction(t, NewEstimateCostFunction(), types.Float64Unknown(),
+				types.StringValue(tt.model), types.Int64Value(tt.input), types.Int64Value(tt.out))
+			if assert.NotNil(t, resp.Error) {
+				assert.Equal(t, tt.argument, *resp.Error.FunctionArgument)
+			}
+		})
+	}
+}
@@ -0,0 +1,829 @@
+package openai
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	fakeAPIKey   = "test-api-key"
+	fakeAdminKey = "test-admin-key"
+)
+
+// fakeObject is an API object as it is returned in JSON.
+type fakeObject map[string]interface{}
+
+// fakeCollection holds the objects of one kind in creation order.
+type fakeCollection struct {
+	ids     []string
+	objects map[string]fakeObject
+}
+
+func (c *fakeCollection) add(o fakeObject) {
+	id := o["id"].(string)
+	c.ids = append(c.ids, id)
+	c.objects[id] = o
+}
+
+func (c *fakeCollection) remove(id string) {
+	delete(c.objects, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// fakeOpenAI is a stateful, in-memory OpenAI API serving the files,
+// assistants, threads and runs, vector stores, batches, fine-tuning, projects
+// and project service accounts endpoints, so resource lifecycles can be tested
+// without network access or API keys.
+// Requests must be authenticated with fakeAPIKey, or fakeAdminKey for the
+// organization endpoints.
+type fakeOpenAI struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	seq         int
+	now         int64
+	collections map[string]*fakeCollection
+	contents    map[string][]byte
+	// accountProjects maps service account IDs to their project.
+	accountProjects map[string]string
+	// requests records each request as "METHOD /path".
+	requests []string
+	// failures are the requests to answer with a server error.
+	failures []*fakeFailure
+}
+
+// fakeFailure fails the next remaining requests whose path matches pattern.
+type fakeFailure struct {
+	method    string
+	pattern   string
+	remaining int
+}
+
+func newFakeOpenAI(t *testing.T) *fakeOpenAI {
+	f := &fakeOpenAI{
+		now:             1700000000,
+		collections:     map[string]*fakeCollection{},
+		contents:        map[string][]byte{},
+		accountProjects: map[string]string{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/files", f.apiKey(f.createFile))
+	mux.HandleFunc("GET /v1/files", f.apiKey(f.list("files")))
+	mux.HandleFunc("GET /v1/files/{id}", f.apiKey(f.retrieve("files")))
+	mux.HandleFunc("GET /v1/files/{id}/content", f.apiKey(f.retrieveFileContent))
+	mux.HandleFunc("DELETE /v1/files/{id}", f.apiKey(f.deleteFile))
+
+	mux.HandleFunc("POST /v1/assistants", f.apiKey(f.createAssistant))
+	mux.HandleFunc("GET /v1/assistants", f.apiKey(f.list("assistants")))
+	mux.HandleFunc("GET /v1/assistants/{id}", f.apiKey(f.retrieve("assistants")))
+	mux.HandleFunc("POST /v1/assistants/{id}", f.apiKey(f.modify("assistants")))
+	mux.HandleFunc("DELETE /v1/assistants/{id}", f.apiKey(f.delete("assistants", "assistant.deleted")))
+
+	mux.HandleFunc("POST /v1/threads/runs", f.apiKey(f.createThreadAndRun))
+	mux.HandleFunc("DELETE /v1/threads/{id}", f.apiKey(f.delete("threads", "thread.deleted")))
+	mux.HandleFunc("GET /v1/threads/{thread_id}/runs/{id}", f.apiKey(f.retrieveRun))
+	mux.HandleFunc("POST /v1/threads/{thread_id}/runs/{id}/cancel", f.apiKey(f.cancelRun))
+	mux.HandleFunc("GET /v1/threads/{thread_id}/messages", f.apiKey(f.listMessages))
+
+	mux.HandleFunc("POST /v1/vector_stores", f.apiKey(f.createVectorStore))
+	mux.HandleFunc("GET /v1/vector_stores", f.apiKey(f.list("vector_stores")))
+	mux.HandleFunc("GET /v1/vector_stores/{id}", f.apiKey(f.retrieve("vector_stores")))
+	mux.HandleFunc("DELETE /v1/vector_stores/{id}", f.apiKey(f.delete("vector_stores", "vector_store.deleted")))
+
+	mux.HandleFunc("POST /v1/batches", f.apiKey(f.createBatch))
+	mux.HandleFunc("GET /v1/batches/{id}", f.apiKey(f.retrieveBatch))
+	mux.HandleFunc("POST /v1/batches/{id}/cancel", f.apiKey(f.cancelBatch))
+
+	mux.HandleFunc("POST /v1/fine_tuning/jobs", f.apiKey(f.createFineTuningJob))
+	mux.HandleFunc("GET /v1/fine_tuning/jobs", f.apiKey(f.list("fine_tuning_jobs")))
+	mux.HandleFunc("GET /v1/fine_tuning/jobs/{id}", f.apiKey(f.retrieveFineTuningJob))
+	mux.HandleFunc("POST /v1/fine_tuning/jobs/{id}/cancel", f.apiKey(f.cancelFineTuningJob))
+	mux.HandleFunc("GET /v1/fine_tuning/jobs/{id}/events", f.apiKey(f.list("fine_tuning_events")))
+	mux.HandleFunc("GET /v1/models/{id}", f.apiKey(f.retrieve("models")))
+	mux.HandleFunc("DELETE /v1/models/{id}", f.apiKey(f.delete("models", "model")))
+
+	mux.HandleFunc("POST /v1/organization/projects", f.adminKey(f.createProject))
+	mux.HandleFunc("GET /v1/organization/projects", f.adminKey(f.list("projects")))
+	mux.HandleFunc("GET /v1/organization/projects/{id}", f.adminKey(f.retrieve("projects")))
+	mux.HandleFunc("POST /v1/organization/projects/{id}", f.adminKey(f.modify("projects")))
+	mux.HandleFunc("POST /v1/organization/projects/{id}/archive", f.adminKey(f.archiveProject))
+	mux.HandleFunc("POST /v1/organization/projects/{project_id}/service_accounts", f.adminKey(f.createServiceAccount))
+	mux.HandleFunc("GET /v1/organization/projects/{project_id}/service_accounts", f.adminKey(f.listServiceAccounts))
+	mux.HandleFunc("GET /v1/organization/projects/{project_id}/service_accounts/{id}", f.adminKey(f.retrieveServiceAccount))
+	mux.HandleFunc("DELETE /v1/organization/projects/{project_id}/service_accounts/{id}", f.adminKey(f.deleteServiceAccount))
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeError(w, http.StatusNotFound, "invalid_request_error", "", fmt.Sprintf("Invalid URL (%s %s)", r.Method, r.URL.Path))
+	})
+
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-request-id", fmt.Sprintf("req_fake%d", len(f.requests)))
+		for _, failure := range f.failures {
+			if matched, _ := path.Match(failure.pattern, r.URL.Path); failure.remaining > 0 && r.Method == failure.method && matched {
+				failure.remaining--
+				writeFakeError(w, http.StatusInternalServerError, "server_error", "", "The server had an error while processing your request.")
+				return
+			}
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(f.Server.Close)
+	return f
+}
+
+// fail answers the next n requests with the method and a path matching
+// pattern, as in path.Match, with a server error.
+func (f *fakeOpenAI) fail(method string, pattern string, n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, &fakeFailure{method: method, pattern: pattern, remaining: n})
+}
+
+// get returns a copy of an object, or nil when it does not exist.
+func (f *fakeOpenAI) get(collection string, id string) fakeObject {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	o, ok := f.collection(collection).objects[id]
+	if !ok {
+		return nil
+	}
+	return copyFakeObject(o)
+}
+
+// put creates or replaces an object, e.g. to simulate changes made outside of Terraform.
+func (f *fakeOpenAI) put(collection string, o fakeObject) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := f.collection(collection)
+	if _, ok := c.objects[o["id"].(string)]; ok {
+		c.objects[o["id"].(string)] = o
+		return
+	}
+	c.add(o)
+}
+
+// remove deletes an object, e.g. to simulate a deletion made outside of Terraform.
+func (f *fakeOpenAI) remove(collection string, id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.collection(collection).remove(id)
+}
+
+func (f *fakeOpenAI) collection(name string) *fakeCollection {
+	c, ok := f.collections[name]
+	if !ok {
+		c = &fakeCollection{objects: map[string]fakeObject{}}
+		f.collections[name] = c
+	}
+	return c
+}
+
+// newObject returns a new object with a unique ID and the next timestamp.
+func (f *fakeOpenAI) newObject(prefix string, object string) fakeObject {
+	f.seq++
+	f.now++
+	return fakeObject{
+		"id":         fmt.Sprintf("%s_fake%03d", prefix, f.seq),
+		"object":     object,
+		"created_at": f.now,
+	}
+}
+
+func (f *fakeOpenAI) apiKey(next http.HandlerFunc) http.HandlerFunc {
+	return f.authenticated(fakeAPIKey, next)
+}
+
+func (f *fakeOpenAI) adminKey(next http.HandlerFunc) http.HandlerFunc {
+	return f.authenticated(fakeAdminKey, next)
+}
+
+func (f *fakeOpenAI) authenticated(key string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if auth != "Bearer "+key {
+			writeFakeError(w, http.StatusUnauthorized, "invalid_request_error", "invalid_api_key",
+				fmt.Sprintf("Incorrect API key provided: %s.", strings.TrimPrefix(auth, "Bearer ")))
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (f *fakeOpenAI) list(collection string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c := f.collection(collection)
+		var objects []fakeObject
+		for _, id := range c.ids {
+			o := c.objects[id]
+			// Fine-tuning events are listed per job.
+			if jobId := r.PathValue("id"); jobId != "" && o["fine_tuning_job_id"] != jobId {
+				continue
+			}
+			objects = append(objects, o)
+		}
+		writeFakeList(w, r, objects)
+	}
+}
+
+func (f *fakeOpenAI) retrieve(collection string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o, ok := f.collection(collection).objects[r.PathValue("id")]
+		if !ok {
+			writeFakeNotFound(w, r.PathValue("id"))
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, o)
+	}
+}
+
+// modify merges the fields of the request into the object.
+func (f *fakeOpenAI) modify(collection string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o, ok := f.collection(collection).objects[r.PathValue("id")]
+		if !ok {
+			writeFakeNotFound(w, r.PathValue("id"))
+			return
+		}
+		body, ok := readFakeBody(w, r)
+		if !ok {
+			return
+		}
+		for k, v := range body {
+			o[k] = v
+		}
+		writeFakeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (f *fakeOpenAI) delete(collection string, object string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		c := f.collection(collection)
+		if _, ok := c.objects[id]; !ok {
+			writeFakeNotFound(w, id)
+			return
+		}
+		c.remove(id)
+		writeFakeJSON(w, http.StatusOK, fakeObject{"id": id, "object": object, "deleted": true})
+	}
+}
+
+func (f *fakeOpenAI) createFile(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", err.Error())
+		return
+	}
+	purpose := r.FormValue("purpose")
+	if purpose == "" {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", "Missing required parameter: 'purpose'.")
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", "Missing required parameter: 'file'.")
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", err.Error())
+		return
+	}
+
+	o := f.newObject("file", "file")
+	o["bytes"] = len(content)
+	o["filename"] = header.Filename
+	o["purpose"] = purpose
+	o["status"] = "processed"
+	f.collection("files").add(o)
+	f.contents[o["id"].(string)] = content
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) retrieveFileContent(w http.ResponseWriter, r *http.Request) {
+	content, ok := f.contents[r.PathValue("id")]
+	if !ok {
+		writeFakeNotFound(w, r.PathValue("id"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(content)
+}
+
+func (f *fakeOpenAI) deleteFile(w http.ResponseWriter, r *http.Request) {
+	f.delete("files", "file")(w, r)
+	delete(f.contents, r.PathValue("id"))
+}
+
+func (f *fakeOpenAI) createAssistant(w http.ResponseWriter, r *http.Request) {
+	body, ok := readFakeBody(w, r)
+	if !ok {
+		return
+	}
+	if body["model"] == nil || body["model"] == "" {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", "Missing required parameter: 'model'.")
+		return
+	}
+
+	o := f.newObject("asst", "assistant")
+	o["name"] = nil
+	o["description"] = nil
+	o["instructions"] = nil
+	o["tools"] = []interface{}{}
+	o["tool_resources"] = fakeObject{}
+	o["metadata"] = fakeObject{}
+	o["temperature"] = 1.0
+	o["top_p"] = 1.0
+	o["response_format"] = "auto"
+	for k, v := range body {
+		if v != nil {
+			o[k] = v
+		}
+	}
+	f.collection("assistants").add(o)
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) createVectorStore(w http.ResponseWriter, r *http.Request) {
+	body, ok := readFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	var usageBytes int
+	fileIds, _ := body["file_ids"].([]interface{})
+	for _, id := range fileIds {
+		file, ok := f.collection("files").objects[fmt.Sprint(id)]
+		if !ok {
+			writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", fmt.Sprintf("File %s not found.", id))
+			return
+		}
+		usageBytes += file["bytes"].(int)
+	}
+
+	o := f.newObject("vs", "vector_store")
+	o["name"] = body["name"]
+	o["usage_bytes"] = usageBytes
+	o["status"] = "completed"
+	o["file_counts"] = fakeObject{"in_progress": 0, "completed": len(fileIds), "failed": 0, "cancelled": 0, "total": len(fileIds)}
+	o["last_active_at"] = o["created_at"]
+	o["metadata"] = fakeObject{}
+	if metadata, ok := body["metadata"].(map[string]interface{}); ok {
+		o["metadata"] = metadata
+	}
+	if expiresAfter, ok := body["expires_after"].(map[string]interface{}); ok {
+		o["expires_after"] = expiresAfter
+		o["expires_at"] = o["created_at"].(int64) + int64(expiresAfter["days"].(float64))*86400
+	}
+	f.collection("vector_stores").add(o)
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+// createFineTuningJob queues a job. Each retrieval moves the job on to its next
+// status, so a job has succeeded by the time it is retrieved twice.
+func (f *fakeOpenAI) createFineTuningJob(w http.ResponseWriter, r *http.Request) {
+	body, ok := readFakeBody(w, r)
+	if !ok {
+		return
+	}
+	for _, param := range []string{"training_file", "validation_file"} {
+		if id, ok := body[param].(string); ok {
+			if _, ok := f.collection("files").objects[id]; !ok {
+				writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", fmt.Sprintf("invalid %s: %s", param, id))
+				return
+			}
+		}
+	}
+
+	o := f.newObject("ftjob", "fine_tuning.job")
+	o["model"] = body["model"]
+	o["training_file"] = body["training_file"]
+	o["validation_file"] = body["validation_file"]
+	o["organization_id"] = "org-fake"
+	o["status"] = "validating_files"
+	o["error"] = nil
+	o["fine_tuned_model"] = nil
+	o["finished_at"] = nil
+	o["trained_tokens"] = nil
+	o["result_files"] = []interface{}{}
+	o["hyperparameters"] = fakeObject{"n_epochs": "auto"}
+	o["hyperparams"] = fakeObject{"n_epochs": 0}
+	f.collection("fine_tuning_jobs").add(o)
+	f.addFineTuningEvent(o, "Validating training file: "+fmt.Sprint(body["training_file"]))
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) retrieveFineTuningJob(w http.ResponseWriter, r *http.Request) {
+	o, ok := f.collection("fine_tuning_jobs").objects[r.PathValue("id")]
+	if !ok {
+		writeFakeNotFound(w, r.PathValue("id"))
+		return
+	}
+	switch o["status"] {
+	case "validating_files", "queued":
+		o["status"] = "running"
+		o["hyperparams"] = fakeObject{"n_epochs": 3}
+		f.addFineTuningEvent(o, "Fine-tuning job started")
+	case "running":
+		// Training files named invalid* fail the job, like files with malformed examples.
+		if file, ok := f.collection("files").objects[o["training_file"].(string)]; ok && strings.HasPrefix(file["filename"].(string), "invalid") {
+			o["status"] = "failed"
+			o["error"] = fakeObject{"code": "invalid_training_file", "message": "The training file has malformed examples.", "param": "training_file"}
+			f.addFineTuningEvent(o, "The job failed")
+			break
+		}
+		f.now++
+		id := strings.TrimPrefix(o["id"].(string), "ftjob_")
+		o["status"] = "succeeded"
+		o["finished_at"] = f.now
+		o["trained_tokens"] = 1024
+		o["fine_tuned_model"] = fmt.Sprintf("ft:%s:fake-org::%s", o["model"], id)
+
+		results := f.newObject("file", "file")
+		results["bytes"] = 0
+		results["filename"] = "step_metrics.csv"
+		results["purpose"] = "fine-tune-results"
+		f.collection("files").add(results)
+		o["result_files"] = []interface{}{results["id"]}
+
+		model := fakeObject{"id": o["fine_tuned_model"], "object": "model", "created": f.now, "owned_by": "fake-org"}
+		f.collection("models").add(model)
+		f.addFineTuningEvent(o, "The job has successfully completed")
+	}
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) cancelFineTuningJob(w http.ResponseWriter, r *http.Request) {
+	o, ok := f.collection("fine_tuning_jobs").objects[r.PathValue("id")]
+	if !ok {
+		writeFakeNotFound(w, r.PathValue("id"))
+		return
+	}
+	if isFineTuningJobTerminal(o["status"].(string)) {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", fmt.Sprintf("Job has already %s", o["status"]))
+		return
+	}
+	o["status"] = "cancelled"
+	f.addFineTuningEvent(o, "The job has been cancelled")
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) addFineTuningEvent(job fakeObject, message string) {
+	e := f.newObject("ftevent", "fine_tuning.job.event")
+	e["fine_tuning_job_id"] = job["id"]
+	e["level"] = "info"
+	e["message"] = message
+	e["type"] = "message"
+	f.collection("fine_tuning_events").add(e)
+}
+
+// createThreadAndRun creates a thread with the messages of the request and a
+// queued run on it.
+func (f *fakeOpenAI) createThreadAndRun(w http.ResponseWriter, r *http.Request) {
+	body, ok := readFakeBody(w, r)
+	if !ok {
+		return
+	}
+	assistantId, _ := body["assistant_id"].(string)
+	assistant, ok := f.collection("assistants").objects[assistantId]
+	if !ok {
+		writeFakeNotFound(w, assistantId)
+		return
+	}
+
+	thread := f.newObject("thread", "thread")
+	f.collection("threads").add(thread)
+	if t, ok := body["thread"].(map[string]interface{}); ok {
+		messages, _ := t["messages"].([]interface{})
+		for _, m := range messages {
+			m, _ := m.(map[string]interface{})
+			f.addMessage(thread, nil, fmt.Sprint(m["role"]), fmt.Sprint(m["content"]))
+		}
+	}
+
+	run := f.newObject("run", "thread.run")
+	run["thread_id"] = thread["id"]
+	run["assistant_id"] = assistantId
+	run["model"] = assistant["model"]
+	run["status"] = "queued"
+	run["last_error"] = nil
+	run["completed_at"] = nil
+	run["usage"] = nil
+	f.collection("runs").add(run)
+	writeFakeJSON(w, http.StatusOK, run)
+}
+
+// retrieveRun moves the run forward on every request, from queued to
+// in_progress and then to completed, when it replies to the last user message.
+func (f *fakeOpenAI) retrieveRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := f.collection("runs").objects[r.PathValue("id")]
+	if !ok || run["thread_id"] != r.PathValue("thread_id") {
+		writeFakeNotFound(w, r.PathValue("id"))
+		return
+	}
+	switch run["status"] {
+	case "queued":
+		run["status"] = "in_progress"
+	case "in_progress":
+		prompt := ""
+		messages := f.collection("messages")
+		for _, id := range messages.ids {
+			if m := messages.objects[id]; m["thread_id"] == run["thread_id"] && m["role"] == "user" {
+				prompt = m["content"].([]interface{})[0].(fakeObject)["text"].(fakeObject)["value"].(string)
+			}
+		}
+		thread := f.collection("threads").objects[run["thread_id"].(string)]
+		f.addMessage(thread, run, "assistant", "You said: "+prompt)
+		f.now++
+		run["status"] = "completed"
+		run["completed_at"] = f.now
+		run["usage"] = fakeObject{"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15}
+	}
+	writeFakeJSON(w, http.StatusOK, run)
+}
+
+func (f *fakeOpenAI) cancelRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := f.collection("runs").objects[r.PathValue("id")]
+	if !ok || run["thread_id"] != r.PathValue("thread_id") {
+		writeFakeNotFound(w, r.PathValue("id"))
+		return
+	}
+	run["status"] = "cancelled"
+	writeFakeJSON(w, http.StatusOK, run)
+}
+
+func (f *fakeOpenAI) listMessages(w http.ResponseWriter, r *http.Request) {
+	threadId := r.PathValue("thread_id")
+	if _, ok := f.collection("threads").objects[threadId]; !ok {
+		writeFakeNotFound(w, threadId)
+		return
+	}
+	runId := r.URL.Query().Get("run_id")
+	c := f.collection("messages")
+	var objects []fakeObject
+	for _, id := range c.ids {
+		m := c.objects[id]
+		if m["thread_id"] == threadId && (runId == "" || m["run_id"] == runId) {
+			objects = append(objects, m)
+		}
+	}
+	writeFakeList(w, r, objects)
+}
+
+// addMessage adds a text message to a thread, added by run when it is not nil.
+func (f *fakeOpenAI) addMessage(thread fakeObject, run fakeObject, role string, text string) {
+	m := f.newObject("msg", "thread.message")
+	m["thread_id"] = thread["id"]
+	m["role"] = role
+	m["content"] = []interface{}{fakeObject{"type": "text", "text": fakeObject{"value": text}}}
+	m["assistant_id"] = nil
+	m["run_id"] = nil
+	if run != nil {
+		m["assistant_id"] = run["assistant_id"]
+		m["run_id"] = run["id"]
+	}
+	f.collection("messages").add(m)
+}
+
+func (f *fakeOpenAI) createBatch(w http.ResponseWriter, r *http.Request) {
+	body, ok := readFakeBody(w, r)
+	if !ok {
+		return
+	}
+	inputFileId, _ := body["input_file_id"].(string)
+	if _, ok := f.collection("files").objects[inputFileId]; !ok {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", fmt.Sprintf("invalid input_file_id: %s", inputFileId))
+		return
+	}
+
+	o := f.newObject("batch", "batch")
+	o["endpoint"] = body["endpoint"]
+	o["input_file_id"] = inputFileId
+	o["completion_window"] = body["completion_window"]
+	o["status"] = "validating"
+	o["output_file_id"] = nil
+	o["error_file_id"] = nil
+	o["expires_at"] = f.now + 86400
+	o["request_counts"] = fakeObject{"total": 0, "completed": 0, "failed": 0}
+	o["metadata"] = body["metadata"]
+	f.collection("batches").add(o)
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+// retrieveBatch moves the batch forward on every request, from validating to
+// in_progress and then to completed.
+func (f *fakeOpenAI) retrieveBatch(w http.ResponseWriter, r *http.Request) {
+	o, ok := f.collection("batches").objects[r.PathValue("id")]
+	if !ok {
+		writeFakeNotFound(w, r.PathValue("id"))
+		return
+	}
+	switch o["status"] {
+	case "validating":
+		f.now++
+		o["status"] = "in_progress"
+		o["in_progress_at"] = f.now
+		o["request_counts"] = fakeObject{"total": 2, "completed": 0, "failed": 0}
+	case "in_progress":
+		output := f.newObject("file", "file")
+		output["bytes"] = 0
+		output["filename"] = "batch_output.jsonl"
+		output["purpose"] = "batch_output"
+		f.collection("files").add(output)
+		o["status"] = "completed"
+		o["output_file_id"] = output["id"]
+		o["finalizing_at"] = f.now
+		o["completed_at"] = f.now
+		o["request_counts"] = fakeObject{"total": 2, "completed": 2, "failed": 0}
+	}
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) cancelBatch(w http.ResponseWriter, r *http.Request) {
+	o, ok := f.collection("batches").objects[r.PathValue("id")]
+	if !ok {
+		writeFakeNotFound(w, r.PathValue("id"))
+		return
+	}
+	f.now++
+	o["status"] = "cancelling"
+	o["cancelling_at"] = f.now
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) createProject(w http.ResponseWriter, r *http.Request) {
+	body, ok := readFakeBody(w, r)
+	if !ok {
+		return
+	}
+	if body["name"] == nil || body["name"] == "" {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", "Missing required parameter: 'name'.")
+		return
+	}
+
+	o := f.newObject("proj", "organization.project")
+	o["name"] = body["name"]
+	o["status"] = "active"
+	o["archived_at"] = nil
+	f.collection("projects").add(o)
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) archiveProject(w http.ResponseWriter, r *http.Request) {
+	o, ok := f.collection("projects").objects[r.PathValue("id")]
+	if !ok {
+		writeFakeNotFound(w, r.PathValue("id"))
+		return
+	}
+	f.now++
+	o["status"] = "archived"
+	o["archived_at"] = f.now
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) createServiceAccount(w http.ResponseWriter, r *http.Request) {
+	projectId := r.PathValue("project_id")
+	if _, ok := f.collection("projects").objects[projectId]; !ok {
+		writeFakeNotFound(w, projectId)
+		return
+	}
+	body, ok := readFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	o := f.newObject("svc_acct", "organization.project.service_account")
+	o["name"] = body["name"]
+	o["role"] = "member"
+	f.collection("service_accounts").add(o)
+	f.accountProjects[o["id"].(string)] = projectId
+
+	key := f.newObject("key", "organization.project.service_account.api_key")
+	key["name"] = "Secret Key"
+	key["value"] = fmt.Sprintf("sk-svcacct-fake%03d", f.seq)
+	created := copyFakeObject(o)
+	created["api_key"] = key
+	writeFakeJSON(w, http.StatusOK, created)
+}
+
+func (f *fakeOpenAI) listServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	c := f.collection("service_accounts")
+	var objects []fakeObject
+	for _, id := range c.ids {
+		if f.accountProjects[id] == r.PathValue("project_id") {
+			objects = append(objects, c.objects[id])
+		}
+	}
+	writeFakeList(w, r, objects)
+}
+
+func (f *fakeOpenAI) retrieveServiceAccount(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	o, ok := f.collection("service_accounts").objects[id]
+	if !ok || f.accountProjects[id] != r.PathValue("project_id") {
+		writeFakeNotFound(w, id)
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, o)
+}
+
+func (f *fakeOpenAI) deleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if f.accountProjects[id] != r.PathValue("project_id") {
+		writeFakeNotFound(w, id)
+		return
+	}
+	f.delete("service_accounts", "organization.project.service_account.deleted")(w, r)
+	delete(f.accountProjects, id)
+}
+
+func readFakeBody(w http.ResponseWriter, r *http.Request) (fakeObject, bool) {
+	body := fakeObject{}
+	b, err := io.ReadAll(r.Body)
+	if err == nil && len(b) > 0 {
+		err = json.Unmarshal(b, &body)
+	}
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "invalid_request_error", "", fmt.Sprintf("We could not parse the JSON body of your request: %s", err))
+		return nil, false
+	}
+	return body, true
+}
+
+// writeFakeList writes a page of objects, honouring the limit, after and order parameters.
+func writeFakeList(w http.ResponseWriter, r *http.Request, objects []fakeObject) {
+	query := r.URL.Query()
+	if query.Get("order") != "asc" {
+		for i, j := 0, len(objects)-1; i < j; i, j = i+1, j-1 {
+			objects[i], objects[j] = objects[j], objects[i]
+		}
+	}
+	if after := query.Get("after"); after != "" {
+		for i, o := range objects {
+			if o["id"] == after {
+				objects = objects[i+1:]
+				break
+			}
+		}
+	}
+	limit := 20
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	hasMore := len(objects) > limit
+	if hasMore {
+		objects = objects[:limit]
+	}
+
+	page := fakeObject{"object": "list", "data": objects, "has_more": hasMore, "first_id": nil, "last_id": nil}
+	if len(objects) > 0 {
+		page["first_id"] = objects[0]["id"]
+		page["last_id"] = objects[len(objects)-1]["id"]
+	} else {
+		page["data"] = []fakeObject{}
+	}
+	writeFakeJSON(w, http.StatusOK, page)
+}
+
+func writeFakeNotFound(w http.ResponseWriter, id string) {
+	writeFakeError(w, http.StatusNotFound, "invalid_request_error", "", fmt.Sprintf("No such object: '%s'", id))
+}
+
+func writeFakeError(w http.ResponseWriter, status int, typ string, code string, message string) {
+	e := fakeObject{"message": message, "type": typ, "param": nil, "code": nil}
+	if code != "" {
+		e["code"] = code
+	}
+	writeFakeJSON(w, status, fakeObject{"error": e})
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func copyFakeObject(o fakeObject) fakeObject {
+	c := fakeObject{}
+	for k, v := range o {
+		c[k] = v
+	}
+	return c
+}
@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -43,10 +42,10 @@ func (d *FileDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	file, err := d.client.Files().RetrieveFile(data.Id.ValueString())
+	file, err := d.client.WithContext(ctx).Files().RetrieveFile(data.Id.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read File, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read File", err))
 		return
 	}
 
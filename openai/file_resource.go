@@ -3,20 +3,32 @@ package openai
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/skyscrapr/openai-sdk-go/openai"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &FileResource{}
 var _ resource.ResourceWithImportState = &FileResource{}
+var _ resource.ResourceWithConfigValidators = &FileResource{}
+
+// defaultContentFilename is the name used when uploading content without a filename.
+const defaultContentFilename = "content.jsonl"
 
 func NewFileResource() resource.Resource {
 	return &FileResource{OpenAIResource: &OpenAIResource{}}
@@ -50,29 +62,56 @@ func (r *FileResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 			},
 			"filename": schema.StringAttribute{
-				MarkdownDescription: "Filename",
+				MarkdownDescription: "Filename. Defaults to the base name of `filepath`, or `" + defaultContentFilename + "` when uploading `content`.",
 				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					// Removing the filename from the configuration keeps the name of the uploaded file.
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.ConfigValue.IsNull()
+					}, "Changing the filename requires the file to be uploaded again.", "Changing the filename requires the file to be uploaded again."),
+				},
 			},
 			"filepath": schema.StringAttribute{
-				MarkdownDescription: "Filename",
-				Required:            true,
+				MarkdownDescription: "Path of the file to upload, relative to the working directory. Exactly one of `filepath` or `content` must be set.",
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the file to upload, such as the `jsonl` of an `openai_batch_input` data source. Exactly one of `filepath` or `content` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"object": schema.StringAttribute{
 				MarkdownDescription: "Object Type",
 				Computed:            true,
 			},
 			"purpose": schema.StringAttribute{
-				MarkdownDescription: "Intended use of file. Use 'fine-tune' for Fine-tuning",
+				MarkdownDescription: "Intended use of file. Use 'fine-tune' for Fine-tuning and 'batch' for the Batch API. One of assistants, batch, fine-tune, vision, user_data or evals.",
 				Computed:            true,
 				Optional:            true,
 				Default:             stringdefault.StaticString("fine-tune"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("assistants", "batch", "fine-tune", "vision", "user_data", "evals"),
+				},
 			},
 		},
 	}
 }
 
+func (r *FileResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("filepath"),
+			path.MatchRoot("content"),
+		),
+	}
+}
+
 func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data OpenAIFileModel
+	var data OpenAIFileResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -81,30 +120,50 @@ func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	filePath, err := GetFilePath(data.Filepath.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("UploadFile", fmt.Sprintf("Unable to upload %s, got error: %s", *filePath, err))
-		return
+	var content io.Reader
+	filename := data.Filename.ValueString()
+	if data.Content.IsNull() {
+		filePath, err := GetFilePath(data.Filepath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("UploadFile", fmt.Sprintf("Unable to upload %s, got error: %s", data.Filepath.ValueString(), err))
+			return
+		}
+		f, err := os.Open(*filePath)
+		if err != nil {
+			resp.Diagnostics.AddError("UploadFile", fmt.Sprintf("Unable to upload %s, got error: %s", *filePath, err))
+			return
+		}
+		defer f.Close()
+		content = f
+		if data.Filename.IsUnknown() || data.Filename.IsNull() {
+			filename = filepath.Base(*filePath)
+		}
+	} else {
+		content = strings.NewReader(data.Content.ValueString())
+		if data.Filename.IsUnknown() || data.Filename.IsNull() {
+			filename = defaultContentFilename
+		}
 	}
 
-	file, err := r.client.Files().UploadFile(&openai.UploadFileRequest{
-		File:    *filePath,
-		Purpose: data.Purpose.ValueString(),
+	file, err := r.client.WithContext(ctx).UploadFileContent(&UploadFileContentRequest{
+		Filename: filename,
+		Purpose:  data.Purpose.ValueString(),
+		Content:  content,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to upload File: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to upload File", err))
 		return
 	}
 	tflog.Trace(ctx, "Uploaded file successfully")
 
-	data = NewOpenAIFileModelWithPath(file, data.Filepath.ValueString())
+	data = NewOpenAIFileResourceModel(file, data.Filepath, data.Content)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data OpenAIFileModel
+	var data OpenAIFileResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -113,7 +172,7 @@ func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	file, err := r.client.Files().RetrieveFile(data.Id.ValueString())
+	file, err := r.client.WithContext(ctx).Files().RetrieveFile(data.Id.ValueString())
 	if err != nil {
 		apiError := GetOpenAIAPIError(err)
 		if apiError != nil && apiError.HTTPStatusCode == 404 {
@@ -122,22 +181,34 @@ func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read File, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read File", err))
 		return
 	}
 
-	data = NewOpenAIFileModelWithPath(file, data.Filepath.ValueString())
+	data = NewOpenAIFileResourceModel(file, data.Filepath, data.Content)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	tflog.Trace(ctx, "Update not supported.")
+	var plan, data OpenAIFileResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the path of the file can change in place, the uploaded file is kept.
+	data.Filepath = plan.Filepath
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data OpenAIFileModel
+	var data OpenAIFileResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -150,7 +221,7 @@ func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	destroyTimeout := 20 * time.Minute
 
 	err := retry.RetryContext(ctx, destroyTimeout, func() *retry.RetryError {
-		bDeleted, err := r.client.Files().DeleteFile(data.Id.ValueString())
+		bDeleted, err := r.client.WithContext(ctx).Files().DeleteFile(data.Id.ValueString())
 		if err != nil {
 			apiError := GetOpenAIAPIError(err)
 			if apiError != nil {
@@ -174,7 +245,7 @@ func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to delete File, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to delete File", err))
 		return
 	}
 }
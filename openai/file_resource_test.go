@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccFileResource(t *testing.T) {
@@ -44,6 +45,54 @@ func TestAccFileResource(t *testing.T) {
 	})
 }
 
+func TestAccFileResource_Content(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFileResourceContentConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("openai_file.test", "id"),
+					resource.TestCheckResourceAttr("openai_file.test", "filename", "batch_input.jsonl"),
+					resource.TestCheckResourceAttr("openai_file.test", "purpose", "batch"),
+					resource.TestCheckResourceAttrPair("openai_file.test", "content", "data.openai_batch_input.test", "jsonl"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "openai_file.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFileResourceContentConfig = `
+data "openai_batch_input" "test" {
+	requests = [
+		{
+			custom_id = "request-1"
+			url       = "/v1/embeddings"
+			body = {
+				model = "text-embedding-3-small"
+				input = "Hello world"
+			}
+		},
+	]
+}
+
+resource "openai_file" "test" {
+	content  = data.openai_batch_input.test.jsonl
+	filename = "batch_input.jsonl"
+	purpose  = "batch"
+}
+`
+
 func testAccFileResourceConfig(filename string) string {
 	return fmt.Sprintf(`	
 resource "openai_file" "test" {
@@ -51,3 +100,62 @@ resource "openai_file" "test" {
 }
 `, filename)
 }
+
+func TestFileResource_Lifecycle(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	l := newLifecycleTest(t, fake, "openai_file")
+
+	config := map[string]interface{}{"content": `{"prompt": "hello"}`, "purpose": "batch"}
+	l.apply(config)
+	id := l.attr("id")
+	assert.Equal(t, defaultContentFilename, l.attr("filename"))
+	assert.Equal(t, `{"prompt": "hello"}`, string(fake.contents[id]))
+	l.assertNoChanges(config)
+
+	// The content is not returned by the API, so it cannot be imported.
+	l.importState(id, "content")
+
+	// Changing the content uploads a new file.
+	config["content"] = `{"prompt": "goodbye"}`
+	l.apply(config)
+	assert.Nil(t, fake.get("files", id))
+	id = l.attr("id")
+	l.assertNoChanges(config)
+
+	// Changing the filename uploads a new file, removing it keeps the uploaded file.
+	config["filename"] = "prompts.jsonl"
+	l.apply(config)
+	assert.Nil(t, fake.get("files", id))
+	id = l.attr("id")
+	assert.Equal(t, "prompts.jsonl", l.attr("filename"))
+	delete(config, "filename")
+	l.assertNoChanges(config)
+	l.apply(config)
+	assert.Equal(t, id, l.attr("id"))
+	assert.Equal(t, "prompts.jsonl", l.attr("filename"))
+
+	l.destroy()
+	assert.Nil(t, fake.get("files", id))
+}
+
+func TestFileResource_LifecycleFilepath(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	l := newLifecycleTest(t, fake, "openai_file")
+
+	config := map[string]interface{}{"filepath": "test-fixtures/test.jsonl"}
+	l.apply(config)
+	id := l.attr("id")
+	assert.Equal(t, "test.jsonl", l.attr("filename"))
+	assert.Equal(t, "fine-tune", l.attr("purpose"))
+	l.assertNoChanges(config)
+
+	// Moving the file is an in-place change, which keeps the filename it was uploaded with.
+	config["filepath"] = "test-fixtures/test_prepared_train.jsonl"
+	l.apply(config)
+	assert.Equal(t, id, l.attr("id"))
+	assert.Equal(t, "test.jsonl", l.attr("filename"))
+	l.assertNoChanges(config)
+
+	l.destroy()
+	assert.Nil(t, fake.get("files", id))
+}
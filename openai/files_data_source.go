@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
@@ -64,10 +63,10 @@ func (d *FilesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	files, err := d.client.Files().ListFiles()
+	files, err := d.client.WithContext(ctx).Files().ListFiles()
 
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read Files, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Files", err))
 		return
 	}
 
@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -104,10 +103,10 @@ func (d *FineTuningJobDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	fineTune, err := d.client.FineTuning().GetFineTuningJob(data.Id.ValueString())
+	fineTune, err := d.client.WithContext(ctx).FineTuning().GetFineTuningJob(data.Id.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read FineTune, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read FineTune", err))
 		return
 	}
 
@@ -3,13 +3,22 @@ package openai
 import (
 	"context"
 	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +29,28 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &FineTuningJobResource{}
 var _ resource.ResourceWithImportState = &FineTuningJobResource{}
+var _ resource.ResourceWithModifyPlan = &FineTuningJobResource{}
+
+// fineTuningJobCreateTimeout bounds both job creation retries and waiting for completion.
+const fineTuningJobCreateTimeout = 100 * time.Hour
+
+// defaultFineTuningJobPollInterval is used when poll_interval is not configured.
+const defaultFineTuningJobPollInterval = 10 * time.Second
+
+// fineTuningJobMaxPollBackoff caps the delay between retries of a failed poll.
+const fineTuningJobMaxPollBackoff = 5 * time.Minute
+
+// fineTuningJobPollRetries is the number of consecutive failed polls retried
+// before waiting stops. It is a variable so that tests can lower it.
+var fineTuningJobPollRetries = 5
+
+// fineTuningEventsPageSize is the number of events listed per request while
+// waiting. It is a variable so that tests can lower it.
+var fineTuningEventsPageSize = 100
+
+// fineTuningProgressMaxEvents is the number of most recent events reported
+// when waiting ends.
+const fineTuningProgressMaxEvents = 20
 
 func NewFineTuningJobResource() resource.Resource {
 	return &FineTuningJobResource{OpenAIResource: &OpenAIResource{}}
@@ -88,10 +119,16 @@ func (r *FineTuningJobResource) Schema(ctx context.Context, req resource.SchemaR
 			"training_file": schema.StringAttribute{
 				MarkdownDescription: "Training File Identifier",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"validation_file": schema.StringAttribute{
 				MarkdownDescription: "Validation File Identifier",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"suffix": schema.StringAttribute{
 				MarkdownDescription: "Suffix",
@@ -108,8 +145,16 @@ func (r *FineTuningJobResource) Schema(ctx context.Context, req resource.SchemaR
 				Computed:            true,
 			},
 			"wait": schema.BoolAttribute{
-				MarkdownDescription: "Wait for Fine Tuning Job completion",
+				MarkdownDescription: "Wait for Fine Tuning Job completion. If the apply is interrupted while waiting, or the job cannot be polled after several retries, the job is kept in state and the next apply resumes waiting. " +
+					"Terraform does not display provider output while a resource is being created, so the training events and progress are reported in a warning when waiting ends, and logged at info level while waiting.",
+				Optional: true,
+			},
+			"poll_interval": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds between job status checks while waiting for completion. Defaults to 10.",
 				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 		},
 	}
@@ -134,12 +179,10 @@ func (r *FineTuningJobResource) Create(ctx context.Context, req resource.CreateR
 	}
 	data.Hyperparams.As(ctx, ftreq.Hyperparameters, basetypes.ObjectAsOptions{})
 
-	createTimeout := 100 * time.Hour
-
 	var ftJob *openai.FineTuningJob
 	var err error
-	err = retry.RetryContext(ctx, createTimeout, func() *retry.RetryError {
-		ftJob, err = r.client.FineTuning().CreateFineTuningJob(&ftreq)
+	err = retry.RetryContext(ctx, fineTuningJobCreateTimeout, func() *retry.RetryError {
+		ftJob, err = r.client.WithContext(ctx).FineTuning().CreateFineTuningJob(&ftreq)
 		if err != nil {
 			apiError := GetOpenAIAPIError(err)
 			if apiError != nil && apiError.HTTPStatusCode == 400 {
@@ -151,53 +194,17 @@ func (r *FineTuningJobResource) Create(ctx context.Context, req resource.CreateR
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to create fine tuning job, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create fine tuning job", err))
 		return
 	}
 	tflog.Info(ctx, "FineTuning Job created successfully")
-	data = NewOpenAIFineTuningJobResourceModel(ftJob, data.Wait.ValueBool())
+
+	// Save the job before waiting so an interrupted apply keeps track of it.
+	data = NewOpenAIFineTuningJobResourceModel(ftJob, data.Wait, data.PollInterval)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	if !data.Wait.IsUnknown() && data.Wait.ValueBool() {
-		tflog.Info(ctx, "Waiting for fine tuning job completion...")
-		var lastEvent *string = nil
-
-		err := retry.RetryContext(ctx, createTimeout, func() *retry.RetryError {
-			events, err := r.client.FineTuning().ListFineTuningEvents(ftJob.Id, lastEvent, nil)
-			if err != nil {
-				return retry.NonRetryableError(err)
-			}
-			tflog.Info(ctx, "Fine Tuning Events obtained successfully")
-
-			for _, event := range events {
-				tflog.Info(ctx, fmt.Sprintf("Fine-Tuning Event: %s", event.Message))
-			}
-			if len(events) > 0 {
-				lastEvent = &events[len(events)-1].Id
-			}
-
-			// Update finetuning job state
-			ftJob, err = r.client.FineTuning().GetFineTuningJob(ftJob.Id)
-			if err != nil {
-				return retry.NonRetryableError(err)
-			}
-			data = NewOpenAIFineTuningJobResourceModel(ftJob, data.Wait.ValueBool())
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
-			switch ftJob.Status {
-			case "succeeded":
-				return nil
-			case "created", "running", "validating_files", "queued":
-				tflog.Info(ctx, fmt.Sprintf("Fine-Tuning Job State: %s... Retrying...", ftJob.Status))
-				return retry.RetryableError(fmt.Errorf("fine tuning job still running"))
-			default:
-				return retry.NonRetryableError(fmt.Errorf("unexpected job status: %s", ftJob.Status))
-			}
-		})
-		if err != nil {
-			resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to stream fine tuning events, got error: %s", err))
-			return
-		}
+		resp.Diagnostics.Append(r.waitForFineTuningJob(ctx, &data, &resp.State)...)
 	}
 }
 
@@ -212,20 +219,70 @@ func (r *FineTuningJobResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Reading Fine-Tune with id: %s", data.Id.ValueString()))
-	ftJob, err := r.client.FineTuning().GetFineTuningJob(data.Id.ValueString())
+	ftJob, err := r.client.WithContext(ctx).FineTuning().GetFineTuningJob(data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read Fine Tuning Job, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Fine Tuning Job", err))
 		return
 	}
 
-	data = NewOpenAIFineTuningJobResourceModel(ftJob, data.Wait.ValueBool())
+	data = NewOpenAIFineTuningJobResourceModel(ftJob, data.Wait, data.PollInterval)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (r *FineTuningJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resume on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state OpenAIFineTuningJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A job left running by an interrupted apply is waited on again during update.
+	if !plan.Wait.ValueBool() || isFineTuningJobTerminal(state.Status.ValueString()) {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Fine-Tuning Job %s is %s, planning to resume waiting", state.Id.ValueString(), state.Status.ValueString()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("finished_at"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fine_tuned_model"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("trained_tokens"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("result_files"), types.ListUnknown(types.StringType))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hyperparams"), types.ObjectUnknown(OpenAIFineTuningJobHyperparamsModel{}.AttrTypes()))...)
+}
+
 func (r *FineTuningJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	tflog.Trace(ctx, "Update not supported.")
+	var data OpenAIFineTuningJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	var state OpenAIFineTuningJobResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ftJob, err := r.client.WithContext(ctx).FineTuning().GetFineTuningJob(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Fine Tuning Job", err))
+		return
+	}
+
+	data = NewOpenAIFineTuningJobResourceModel(ftJob, data.Wait, data.PollInterval)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Wait.ValueBool() && !isFineTuningJobTerminal(ftJob.Status) {
+		resp.Diagnostics.Append(r.waitForFineTuningJob(ctx, &data, &resp.State)...)
+	}
 }
 
 func (r *FineTuningJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -239,22 +296,20 @@ func (r *FineTuningJobResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	tflog.Info(ctx, "Get existing Fine-Tune...")
-	ftJob, err := r.client.FineTuning().GetFineTuningJob(data.Id.ValueString())
+	ftJob, err := r.client.WithContext(ctx).FineTuning().GetFineTuningJob(data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read Fine Tune, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Fine Tune", err))
 		return
 	}
 	// need to ignore is fine tune is already delete. - Missing test
 
 	// Cancel fine tune
 	tflog.Info(ctx, fmt.Sprintf("Fine-Tuning-Job.Status: %s", ftJob.Status))
-	switch ftJob.Status {
-	case "succeeded", "cancelled", "failed":
-	default:
+	if !isFineTuningJobTerminal(ftJob.Status) {
 		tflog.Info(ctx, "Cancelling Fine-Tune")
-		_, err = r.client.FineTuning().CancelFineTuningJob(data.Id.ValueString())
+		_, err = r.client.WithContext(ctx).FineTuning().CancelFineTuningJob(data.Id.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to cancel fine tune %s, got error: %s", ftJob.Id, err))
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic(fmt.Sprintf("Unable to cancel fine tune %s", ftJob.Id), err))
 			return
 		}
 	}
@@ -262,7 +317,7 @@ func (r *FineTuningJobResource) Delete(ctx context.Context, req resource.DeleteR
 	// Delete result files
 	for _, file := range ftJob.ResultFiles {
 		tflog.Info(ctx, fmt.Sprintf("Deleting Fine-Tuning Job Result File: %s", file))
-		_, err := r.client.Files().DeleteFile(file)
+		_, err := r.client.WithContext(ctx).Files().DeleteFile(file)
 		if err != nil {
 			apiError := GetOpenAIAPIError(err)
 			if apiError != nil && apiError.HTTPStatusCode == 404 {
@@ -271,7 +326,7 @@ func (r *FineTuningJobResource) Delete(ctx context.Context, req resource.DeleteR
 			}
 		}
 		if err != nil {
-			resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to delete Result File %s, got error: %s", file, err))
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic(fmt.Sprintf("Unable to delete Result File %s", file), err))
 			return
 		}
 	}
@@ -279,14 +334,9 @@ func (r *FineTuningJobResource) Delete(ctx context.Context, req resource.DeleteR
 	// Delete the fine tuned model
 	if ftJob.FineTunedModel != "" {
 		tflog.Info(ctx, fmt.Sprintf("Deleting Fine-Tune Model: %s", ftJob.FineTunedModel))
-		bDeleted, err := r.client.Models().DeleteFineTuneModel(ftJob.FineTunedModel)
+		bDeleted, err := r.client.WithContext(ctx).Models().DeleteFineTuneModel(ftJob.FineTunedModel)
 		if err != nil {
-			if err, ok := err.(*openai.APIError); ok {
-				fmt.Println("openai error:", err.Code)
-				// Or whatever other field(s) you need
-			}
-
-			resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to delete Fime Tuned Model, got error: %s", err))
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to delete Fime Tuned Model", err))
 			return
 		}
 		if !bDeleted {
@@ -299,3 +349,331 @@ func (r *FineTuningJobResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *FineTuningJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func isFineTuningJobTerminal(status string) bool {
+	switch status {
+	case "succeeded", "cancelled", "failed":
+		return true
+	default:
+		return false
+	}
+}
+
+// waitForFineTuningJob polls the job until it succeeds, reporting training progress from the
+// job events and saving every refreshed job into state. As the job is already in state, failing
+// to poll it is retried with backoff, and neither that nor cancelling the context fails the
+// apply, so the job is not tainted and the next apply resumes waiting rather than replacing it.
+func (r *FineTuningJobResource) waitForFineTuningJob(ctx context.Context, data *OpenAIFineTuningJobResourceModel, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pollInterval := defaultFineTuningJobPollInterval
+	if data.PollInterval.ValueInt64() > 0 {
+		pollInterval = time.Duration(data.PollInterval.ValueInt64()) * time.Second
+	}
+
+	jobId := data.Id.ValueString()
+	progress := newFineTuningProgress()
+
+	tflog.Info(ctx, "Waiting for fine tuning job completion...", map[string]interface{}{
+		"fine_tuning_job_id": jobId,
+		"poll_interval":      pollInterval.String(),
+	})
+
+	waitCtx, cancel := context.WithTimeout(ctx, fineTuningJobCreateTimeout)
+	defer cancel()
+
+	failures := 0
+	for {
+		delay := pollInterval
+		ftJob, err := r.pollFineTuningJob(ctx, jobId, progress)
+		if err != nil {
+			failures++
+			if failures > fineTuningJobPollRetries {
+				diags.AddWarning(
+					"Fine-Tuning Job Still Running",
+					fmt.Sprintf("Stopped waiting for fine tuning job %s after %d failed attempts to poll it, got error: %s. The job has been saved to state and the next apply will resume waiting.", jobId, failures, err),
+				)
+				return append(diags, progress.Diagnostics()...)
+			}
+			delay = fineTuningJobPollBackoff(pollInterval, failures)
+			tflog.Warn(ctx, fmt.Sprintf("Unable to poll fine tuning job, retrying in %s: %s", delay, err))
+		} else {
+			failures = 0
+			*data = NewOpenAIFineTuningJobResourceModel(ftJob, data.Wait, data.PollInterval)
+			diags.Append(state.Set(ctx, data)...)
+
+			if ftJob.Status == "succeeded" {
+				break
+			}
+			if isFineTuningJobTerminal(ftJob.Status) {
+				var jobErr *FineTuningJobError
+				if ftJob.Status == "failed" {
+					if jobErr, err = r.client.WithContext(ctx).RetrieveFineTuningJobError(jobId); err != nil {
+						tflog.Warn(ctx, fmt.Sprintf("Unable to retrieve the error of fine tuning job %s: %s", jobId, err))
+					}
+				}
+				diags.Append(newFineTuningJobEndedDiagnostic(ftJob, jobErr))
+				return append(diags, progress.Diagnostics()...)
+			}
+			tflog.Info(ctx, fmt.Sprintf("Fine-Tuning Job State: %s... Retrying...", ftJob.Status))
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-waitCtx.Done():
+			timer.Stop()
+			diags.AddWarning(
+				"Fine-Tuning Job Still Running",
+				fmt.Sprintf("Stopped waiting for fine tuning job %s with status %s: %s. The job has been saved to state and the next apply will resume waiting.", jobId, data.Status.ValueString(), waitCtx.Err()),
+			)
+			return append(diags, progress.Diagnostics()...)
+		case <-timer.C:
+		}
+	}
+
+	diags.AddWarning("Fine-Tuning Job Completed", progress.Summary(data))
+	return diags
+}
+
+// pollFineTuningJob retrieves the job and logs the events and training progress since the last poll.
+func (r *FineTuningJobResource) pollFineTuningJob(ctx context.Context, jobId string, progress *fineTuningProgress) (*openai.FineTuningJob, error) {
+	ftJob, err := r.client.WithContext(ctx).FineTuning().GetFineTuningJob(jobId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read fine tuning job: %w", err)
+	}
+	// The events are listed after the job, so that they include those of its status.
+	events, err := r.listNewFineTuningEvents(ctx, jobId, progress)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list fine tuning events: %w", err)
+	}
+
+	for _, event := range progress.Update(events, ftJob.Hyperparams.NEpochs) {
+		tflog.Info(ctx, fmt.Sprintf("Fine-Tuning Event: %s", event.Message))
+	}
+	if progress.HasSteps() {
+		tflog.Info(ctx, fmt.Sprintf("Fine-Tuning Progress: %s", progress), progress.Fields())
+	}
+	return ftJob, nil
+}
+
+// listNewFineTuningEvents lists the events of the job since the last poll. Events are listed
+// newest first, so pages are read with after set to the oldest event of the previous page,
+// until the oldest event of a page has already been seen or there are no more events.
+func (r *FineTuningJobResource) listNewFineTuningEvents(ctx context.Context, jobId string, progress *fineTuningProgress) ([]openai.FineTuningEvent, error) {
+	var events []openai.FineTuningEvent
+	var after *string
+	limit := fineTuningEventsPageSize
+	for {
+		page, err := r.client.WithContext(ctx).FineTuning().ListFineTuningEvents(jobId, after, &limit)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, page...)
+		if len(page) < limit || progress.Seen(page[len(page)-1].Id) {
+			return events, nil
+		}
+		after = &page[len(page)-1].Id
+	}
+}
+
+// newFineTuningJobEndedDiagnostic returns the error of a job that ended
+// without succeeding, attached to the file attribute the job error is about.
+func newFineTuningJobEndedDiagnostic(ftJob *openai.FineTuningJob, jobErr *FineTuningJobError) diag.Diagnostic {
+	if ftJob.Status == "cancelled" {
+		return diag.NewErrorDiagnostic("Fine-Tuning Job Cancelled", fmt.Sprintf("Fine tuning job %s was cancelled before it completed.", ftJob.Id))
+	}
+	if ftJob.Status != "failed" {
+		return diag.NewErrorDiagnostic("Fine-Tuning Job Failed", fmt.Sprintf("Fine tuning job %s ended with unexpected status: %s", ftJob.Id, ftJob.Status))
+	}
+	if jobErr == nil {
+		return diag.NewErrorDiagnostic("Fine-Tuning Job Failed", fmt.Sprintf("Fine tuning job %s failed.", ftJob.Id))
+	}
+
+	detail := fmt.Sprintf("Fine tuning job %s failed with error %s: %s", ftJob.Id, jobErr.Code, jobErr.Message)
+	if jobErr.Param != nil && (*jobErr.Param == "training_file" || *jobErr.Param == "validation_file") {
+		return diag.NewAttributeErrorDiagnostic(path.Root(*jobErr.Param), "Fine-Tuning Job Failed", detail)
+	}
+	return diag.NewErrorDiagnostic("Fine-Tuning Job Failed", detail)
+}
+
+// fineTuningJobPollBackoff doubles the poll interval for every consecutive failure, up to fineTuningJobMaxPollBackoff.
+func fineTuningJobPollBackoff(pollInterval time.Duration, failures int) time.Duration {
+	backoff := pollInterval
+	for i := 1; i < failures && backoff < fineTuningJobMaxPollBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, fineTuningJobMaxPollBackoff)
+}
+
+var fineTuningStepMessageRegex = regexp.MustCompile(`^Step (\d+)/(\d+): training loss=([0-9.]+)`)
+
+// fineTuningProgress estimates training progress from fine-tuning job events.
+type fineTuningProgress struct {
+	seen map[string]bool
+	// messages are the events other than training steps, in chronological order.
+	messages []string
+
+	nEpochs    int64
+	step       int64
+	totalSteps int64
+	stepAt     int
+	loss       float64
+
+	firstStep   int64
+	firstStepAt int
+}
+
+func newFineTuningProgress() *fineTuningProgress {
+	return &fineTuningProgress{seen: map[string]bool{}}
+}
+
+// Update records events not seen before and returns them in chronological order.
+func (p *fineTuningProgress) Update(events []openai.FineTuningEvent, nEpochs int64) []openai.FineTuningEvent {
+	if nEpochs > 0 {
+		p.nEpochs = nEpochs
+	}
+
+	var unseen []openai.FineTuningEvent
+	for _, event := range events {
+		if !p.seen[event.Id] {
+			p.seen[event.Id] = true
+			unseen = append(unseen, event)
+		}
+	}
+	sort.SliceStable(unseen, func(i, j int) bool {
+		return unseen[i].CreatedAt < unseen[j].CreatedAt
+	})
+
+	for _, event := range unseen {
+		matches := fineTuningStepMessageRegex.FindStringSubmatch(event.Message)
+		if matches == nil {
+			p.messages = append(p.messages, event.Message)
+			continue
+		}
+		step, _ := strconv.ParseInt(matches[1], 10, 64)
+		totalSteps, _ := strconv.ParseInt(matches[2], 10, 64)
+		loss, _ := strconv.ParseFloat(matches[3], 64)
+		if step < p.step {
+			continue
+		}
+		if p.firstStepAt == 0 {
+			p.firstStep = step
+			p.firstStepAt = event.CreatedAt
+		}
+		p.step = step
+		p.totalSteps = totalSteps
+		p.stepAt = event.CreatedAt
+		p.loss = loss
+	}
+	return unseen
+}
+
+// Seen reports whether the event has already been recorded.
+func (p *fineTuningProgress) Seen(id string) bool {
+	return p.seen[id]
+}
+
+// HasSteps reports whether any training step events have been seen.
+func (p *fineTuningProgress) HasSteps() bool {
+	return p.totalSteps > 0
+}
+
+// Percent returns the share of training steps completed.
+func (p *fineTuningProgress) Percent() float64 {
+	if p.totalSteps == 0 {
+		return 0
+	}
+	return float64(p.step) * 100 / float64(p.totalSteps)
+}
+
+// Epoch returns the current epoch, or 0 when the number of epochs is unknown.
+func (p *fineTuningProgress) Epoch() int64 {
+	if p.nEpochs == 0 || p.totalSteps == 0 || p.step == 0 {
+		return 0
+	}
+	return (p.step-1)*p.nEpochs/p.totalSteps + 1
+}
+
+// ETA estimates the remaining training time from the step rate observed so far.
+func (p *fineTuningProgress) ETA() (time.Duration, bool) {
+	if p.step <= p.firstStep || p.stepAt <= p.firstStepAt {
+		return 0, false
+	}
+	stepsPerSecond := float64(p.step-p.firstStep) / float64(p.stepAt-p.firstStepAt)
+	remaining := float64(p.totalSteps-p.step) / stepsPerSecond
+	return time.Duration(remaining * float64(time.Second)).Round(time.Second), true
+}
+
+// Fields returns the progress as structured log fields.
+func (p *fineTuningProgress) Fields() map[string]interface{} {
+	fields := map[string]interface{}{
+		"step":          p.step,
+		"total_steps":   p.totalSteps,
+		"percent":       math.Round(p.Percent()*10) / 10,
+		"training_loss": p.loss,
+	}
+	if epoch := p.Epoch(); epoch > 0 {
+		fields["epoch"] = epoch
+		fields["n_epochs"] = p.nEpochs
+	}
+	if eta, ok := p.ETA(); ok {
+		fields["eta_seconds"] = int64(eta.Seconds())
+	}
+	return fields
+}
+
+func (p *fineTuningProgress) String() string {
+	s := fmt.Sprintf("step %d/%d (%.1f%%)", p.step, p.totalSteps, p.Percent())
+	if epoch := p.Epoch(); epoch > 0 {
+		s += fmt.Sprintf(", epoch %d/%d", epoch, p.nEpochs)
+	}
+	s += fmt.Sprintf(", training loss %.4f", p.loss)
+	if eta, ok := p.ETA(); ok {
+		s += fmt.Sprintf(", ETA %s", eta)
+	}
+	return s
+}
+
+// Summary describes the outcome of a completed job, followed by its report.
+func (p *fineTuningProgress) Summary(data *OpenAIFineTuningJobResourceModel) string {
+	s := fmt.Sprintf("Fine tuning job %s %s with fine tuned model %s. Trained tokens: %d.",
+		data.Id.ValueString(), data.Status.ValueString(), data.FineTunedModel.ValueString(), data.TrainedTokens.ValueInt64())
+	if p.HasSteps() {
+		s += fmt.Sprintf(" Final training loss: %.4f (step %d/%d).", p.loss, p.step, p.totalSteps)
+	}
+	if report := p.Report(); report != "" {
+		s += "\n\n" + report
+	}
+	return s
+}
+
+// Report lists the most recent events and the training progress, or is empty
+// when no events have been seen.
+func (p *fineTuningProgress) Report() string {
+	var lines []string
+	if len(p.messages) > 0 {
+		lines = append(lines, "Events:")
+		messages := p.messages
+		if omitted := len(messages) - fineTuningProgressMaxEvents; omitted > 0 {
+			lines = append(lines, fmt.Sprintf("  (%d earlier events omitted)", omitted))
+			messages = messages[omitted:]
+		}
+		for _, message := range messages {
+			lines = append(lines, "  "+message)
+		}
+	}
+	if p.HasSteps() {
+		lines = append(lines, fmt.Sprintf("Progress: %s", p))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Diagnostics reports the events and training progress of a job that did not
+// complete, as Terraform does not display the logs while waiting.
+func (p *fineTuningProgress) Diagnostics() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if report := p.Report(); report != "" {
+		diags.AddWarning("Fine-Tuning Job Progress", report)
+	}
+	return diags
+}
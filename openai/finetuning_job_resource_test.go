@@ -1,10 +1,17 @@
 package openai
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/skyscrapr/openai-sdk-go/openai"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccFineTuningJobResource(t *testing.T) {
@@ -30,7 +37,7 @@ func TestAccFineTuningJobResource(t *testing.T) {
 				// example code does not have an actual upstream service.
 				// Once the Read method is able to refresh information from
 				// the upstream service, this can be removed.
-				ImportStateVerifyIgnore: []string{"wait"},
+				ImportStateVerifyIgnore: []string{"wait", "poll_interval"},
 			},
 			// // Update and Read testing
 			// {
@@ -62,3 +69,220 @@ resource "openai_finetuning_job" "test" {
 }
 `, training_file, validation_file)
 }
+
+func TestFineTuningProgress(t *testing.T) {
+	p := newFineTuningProgress()
+	assert.False(t, p.HasSteps())
+
+	// Events are listed newest first
+	events := []openai.FineTuningEvent{
+		{Id: "ev-3", CreatedAt: 1100, Message: "Step 20/100: training loss=0.80"},
+		{Id: "ev-2", CreatedAt: 1000, Message: "Step 10/100: training loss=1.20"},
+		{Id: "ev-1", CreatedAt: 900, Message: "Fine-tuning job started"},
+	}
+	unseen := p.Update(events, 4)
+	assert.Equal(t, []string{"ev-1", "ev-2", "ev-3"}, []string{unseen[0].Id, unseen[1].Id, unseen[2].Id})
+	assert.True(t, p.HasSteps())
+	assert.Equal(t, 20.0, p.Percent())
+	assert.Equal(t, int64(1), p.Epoch())
+	eta, ok := p.ETA()
+	assert.True(t, ok)
+	assert.Equal(t, 800*time.Second, eta)
+
+	// Only new events are returned on the next poll
+	events = append([]openai.FineTuningEvent{{Id: "ev-4", CreatedAt: 1200, Message: "Step 30/100: training loss=0.50"}}, events...)
+	unseen = p.Update(events, 4)
+	assert.Len(t, unseen, 1)
+	assert.Equal(t, int64(2), p.Epoch())
+	assert.Equal(t, 0.5, p.Fields()["training_loss"])
+	assert.Equal(t, "step 30/100 (30.0%), epoch 2/4, training loss 0.5000, ETA 11m40s", p.String())
+}
+
+func TestFineTuningJobResource_Lifecycle(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("files", fakeObject{"id": "file_train", "object": "file", "bytes": 2048, "filename": "train.jsonl", "purpose": "fine-tune", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_finetuning_job")
+
+	config := map[string]interface{}{
+		"model":         "gpt-4o-mini-2024-07-18",
+		"training_file": "file_train",
+		"wait":          true,
+		"poll_interval": 1,
+	}
+	l.apply(config)
+	id := l.attr("id")
+	model := l.attr("fine_tuned_model")
+	assert.Equal(t, "succeeded", l.attr("status"))
+	assert.NotNil(t, fake.get("models", model))
+	resultFiles := fake.get("fine_tuning_jobs", id)["result_files"].([]interface{})
+	assert.Len(t, resultFiles, 1)
+	l.assertNoChanges(config)
+
+	// Waiting is configuration only, so it cannot be imported.
+	l.importState(id, "wait", "poll_interval")
+
+	// Destroying the job deletes its result files and fine-tuned model.
+	l.destroy()
+	assert.Nil(t, fake.get("models", model))
+	assert.Nil(t, fake.get("files", resultFiles[0].(string)))
+	assert.NotNil(t, fake.get("files", "file_train"))
+}
+
+func TestFineTuningJobResource_LifecycleCancel(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("files", fakeObject{"id": "file_train", "object": "file", "bytes": 2048, "filename": "train.jsonl", "purpose": "fine-tune", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_finetuning_job")
+
+	l.apply(map[string]interface{}{"model": "gpt-4o-mini-2024-07-18", "training_file": "file_train"})
+	id := l.attr("id")
+	assert.Equal(t, "validating_files", l.attr("status"))
+
+	// Destroying a running job cancels it.
+	l.destroy()
+	assert.Equal(t, "cancelled", fake.get("fine_tuning_jobs", id)["status"])
+}
+
+func TestFineTuningJobResource_LifecyclePollFailures(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("files", fakeObject{"id": "file_train", "object": "file", "bytes": 2048, "filename": "train.jsonl", "purpose": "fine-tune", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_finetuning_job")
+
+	// Transient failures while waiting are retried.
+	fake.fail("GET", "/v1/fine_tuning/jobs/*/events", 2)
+	config := map[string]interface{}{
+		"model":         "gpt-4o-mini-2024-07-18",
+		"training_file": "file_train",
+		"wait":          true,
+		"poll_interval": 1,
+	}
+	l.apply(config)
+	assert.Equal(t, "succeeded", l.attr("status"))
+	assert.Len(t, fake.collection("fine_tuning_jobs").ids, 1)
+}
+
+func TestFineTuningJobResource_LifecyclePollFailuresResume(t *testing.T) {
+	retries := fineTuningJobPollRetries
+	fineTuningJobPollRetries = 1
+	t.Cleanup(func() { fineTuningJobPollRetries = retries })
+
+	fake := newFakeOpenAI(t)
+	fake.put("files", fakeObject{"id": "file_train", "object": "file", "bytes": 2048, "filename": "train.jsonl", "purpose": "fine-tune", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_finetuning_job")
+
+	// Waiting stops without failing the apply when polling keeps failing.
+	fake.fail("GET", "/v1/fine_tuning/jobs/*/events", 2)
+	config := map[string]interface{}{
+		"model":         "gpt-4o-mini-2024-07-18",
+		"training_file": "file_train",
+		"wait":          true,
+		"poll_interval": 1,
+	}
+	l.apply(config)
+	id := l.attr("id")
+	assert.Equal(t, "validating_files", l.attr("status"))
+
+	// The next apply resumes waiting for the same job.
+	l.apply(config)
+	assert.Equal(t, id, l.attr("id"))
+	assert.Equal(t, "succeeded", l.attr("status"))
+	assert.Len(t, fake.collection("fine_tuning_jobs").ids, 1)
+}
+
+func TestFineTuningJobResource_LifecycleFailed(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("files", fakeObject{"id": "file_train", "object": "file", "bytes": 2048, "filename": "invalid.jsonl", "purpose": "fine-tune", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_finetuning_job")
+
+	diags := l.applyWithErrors(map[string]interface{}{
+		"model":         "gpt-4o-mini-2024-07-18",
+		"training_file": "file_train",
+		"wait":          true,
+		"poll_interval": 1,
+	})
+
+	// The job error is reported against the training file, followed by the job events,
+	// and the job is kept in state.
+	if assert.Len(t, diags, 2) {
+		assert.Equal(t, "Fine-Tuning Job Failed", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "invalid_training_file: The training file has malformed examples.")
+		assert.Equal(t, tftypes.NewAttributePath().WithAttributeName("training_file"), diags[0].Attribute)
+		assert.Equal(t, "Fine-Tuning Job Progress", diags[1].Summary)
+		assert.Equal(t, "Events:\n  Validating training file: file_train\n  Fine-tuning job started\n  The job failed", diags[1].Detail)
+	}
+	assert.Equal(t, "failed", l.attr("status"))
+}
+
+func TestFineTuningJobResource_ListNewFineTuningEvents(t *testing.T) {
+	pageSize := fineTuningEventsPageSize
+	fineTuningEventsPageSize = 2
+	t.Cleanup(func() { fineTuningEventsPageSize = pageSize })
+
+	fake := newFakeOpenAI(t)
+	addEvent := func(i int) {
+		fake.put("fine_tuning_events", fakeObject{"id": fmt.Sprintf("ftevent_%d", i), "object": "fine_tuning.job.event", "fine_tuning_job_id": "ftjob_abc", "created_at": 1700000000 + i, "level": "info", "message": fmt.Sprintf("Event %d", i), "type": "message"})
+	}
+	for i := 1; i <= 5; i++ {
+		addEvent(i)
+	}
+	client := NewOpenAIClient(fakeAPIKey, fakeAdminKey)
+	client.BaseURL, _ = url.Parse(fake.URL)
+	r := &FineTuningJobResource{OpenAIResource: &OpenAIResource{client: client}}
+	progress := newFineTuningProgress()
+
+	// The first poll reads every page.
+	events, err := r.listNewFineTuningEvents(context.Background(), "ftjob_abc", progress)
+	assert.NoError(t, err)
+	assert.Len(t, progress.Update(events, 0), 5)
+	assert.Len(t, fake.requests, 3)
+
+	// Later polls stop at the first page that reaches an event already seen.
+	addEvent(6)
+	events, err = r.listNewFineTuningEvents(context.Background(), "ftjob_abc", progress)
+	assert.NoError(t, err)
+	unseen := progress.Update(events, 0)
+	if assert.Len(t, unseen, 1) {
+		assert.Equal(t, "Event 6", unseen[0].Message)
+	}
+	assert.Len(t, fake.requests, 4)
+}
+
+func TestFineTuningProgressReport(t *testing.T) {
+	p := newFineTuningProgress()
+	assert.Empty(t, p.Report())
+	assert.Empty(t, p.Diagnostics())
+
+	var events []openai.FineTuningEvent
+	for i := 1; i <= fineTuningProgressMaxEvents+2; i++ {
+		events = append(events, openai.FineTuningEvent{Id: fmt.Sprintf("ev-%d", i), CreatedAt: i, Message: fmt.Sprintf("Event %d", i)})
+	}
+	events = append(events, openai.FineTuningEvent{Id: "ev-step", CreatedAt: 100, Message: "Step 10/100: training loss=1.20"})
+	p.Update(events, 0)
+
+	report := p.Report()
+	assert.Contains(t, report, "Events:\n  (2 earlier events omitted)\n  Event 3\n")
+	assert.NotContains(t, report, "Step 10/100")
+	assert.Contains(t, report, "\nProgress: step 10/100 (10.0%), training loss 1.2000")
+	if diags := p.Diagnostics(); assert.Len(t, diags, 1) {
+		assert.Equal(t, "Fine-Tuning Job Progress", diags[0].Summary())
+	}
+}
+
+func TestNewFineTuningJobEndedDiagnostic(t *testing.T) {
+	d := newFineTuningJobEndedDiagnostic(&openai.FineTuningJob{Id: "ftjob_abc", Status: "cancelled"}, nil)
+	assert.Equal(t, "Fine-Tuning Job Cancelled", d.Summary())
+
+	d = newFineTuningJobEndedDiagnostic(&openai.FineTuningJob{Id: "ftjob_abc", Status: "failed"}, nil)
+	assert.Equal(t, "Fine-Tuning Job Failed", d.Summary())
+	assert.Equal(t, "Fine tuning job ftjob_abc failed.", d.Detail())
+
+	d = newFineTuningJobEndedDiagnostic(&openai.FineTuningJob{Id: "ftjob_abc", Status: "failed"}, &FineTuningJobError{Code: "exceeded_quota", Message: "Quota exceeded"})
+	assert.Equal(t, "Fine tuning job ftjob_abc failed with error exceeded_quota: Quota exceeded", d.Detail())
+	_, ok := d.(diag.DiagnosticWithPath)
+	assert.False(t, ok)
+}
+
+func TestFineTuningJobPollBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, fineTuningJobPollBackoff(10*time.Second, 1))
+	assert.Equal(t, 40*time.Second, fineTuningJobPollBackoff(10*time.Second, 3))
+	assert.Equal(t, fineTuningJobMaxPollBackoff, fineTuningJobPollBackoff(10*time.Second, 20))
+}
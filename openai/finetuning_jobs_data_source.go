@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
@@ -124,10 +123,10 @@ func (d *FineTuningJobsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	jobs, err := d.client.FineTuning().ListFineTuningJobs(nil, nil)
+	jobs, err := d.client.WithContext(ctx).FineTuning().ListFineTuningJobs(nil, nil)
 
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read Fine Tuning Jobs, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Fine Tuning Jobs", err))
 		return
 	}
 
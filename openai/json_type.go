@@ -0,0 +1,145 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the JSON string type and value fully satisfy framework interfaces.
+var _ basetypes.StringTypable = JSONStringType{}
+var _ basetypes.StringValuableWithSemanticEquals = JSONStringValue{}
+var _ xattr.ValidateableAttribute = JSONStringValue{}
+
+// JSONStringType is a string attribute type holding a JSON document.
+// Values that differ only in whitespace or key order are treated as equal,
+// so configuration written with jsonencode or a heredoc does not drift from
+// the normalized JSON returned by the API.
+type JSONStringType struct {
+	basetypes.StringType
+}
+
+func (t JSONStringType) String() string {
+	return "JSONStringType"
+}
+
+func (t JSONStringType) ValueType(ctx context.Context) attr.Value {
+	return JSONStringValue{}
+}
+
+func (t JSONStringType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t JSONStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSONStringValue{StringValue: in}, nil
+}
+
+func (t JSONStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// JSONStringValue is the value of a JSONStringType attribute.
+type JSONStringValue struct {
+	basetypes.StringValue
+}
+
+func NewJSONStringValue(value string) JSONStringValue {
+	return JSONStringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewJSONStringNull() JSONStringValue {
+	return JSONStringValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewJSONStringUnknown() JSONStringValue {
+	return JSONStringValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewJSONStringValueFrom encodes v as JSON.
+func NewJSONStringValueFrom(v interface{}) (JSONStringValue, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return NewJSONStringNull(), err
+	}
+	return NewJSONStringValue(string(b)), nil
+}
+
+func (v JSONStringValue) Type(ctx context.Context) attr.Type {
+	return JSONStringType{}
+}
+
+func (v JSONStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values decode to the same JSON document.
+func (v JSONStringValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	var current, other interface{}
+	if err := json.Unmarshal([]byte(v.ValueString()), &current); err != nil {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &other); err != nil {
+		return false, nil
+	}
+	return reflect.DeepEqual(current, other), nil
+}
+
+func (v JSONStringValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			fmt.Sprintf("A string value was provided that is not valid JSON.\n\nGiven Value: %s", v.ValueString()),
+		)
+	}
+}
+
+// Unmarshal decodes the JSON document into target.
+func (v JSONStringValue) Unmarshal(target interface{}) error {
+	return json.Unmarshal([]byte(v.ValueString()), target)
+}
@@ -0,0 +1,73 @@
+package openai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONStringValue_StringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	for name, tc := range map[string]struct {
+		current  string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			current:  `{"type":"object"}`,
+			new:      `{"type":"object"}`,
+			expected: true,
+		},
+		"whitespace": {
+			current:  `{"type":"object","required":["a"]}`,
+			new:      "{\n  \"type\": \"object\",\n  \"required\": [ \"a\" ]\n}\n",
+			expected: true,
+		},
+		"key order": {
+			current:  `{"properties":{"a":{"type":"string"}},"type":"object"}`,
+			new:      `{"type":"object","properties":{"a":{"type":"string"}}}`,
+			expected: true,
+		},
+		"array order": {
+			current:  `{"required":["a","b"]}`,
+			new:      `{"required":["b","a"]}`,
+			expected: false,
+		},
+		"different value": {
+			current:  `{"type":"object"}`,
+			new:      `{"type":"string"}`,
+			expected: false,
+		},
+		"invalid json": {
+			current:  `{"type":"object"}`,
+			new:      `{"type":`,
+			expected: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := NewJSONStringValue(tc.current).StringSemanticEquals(ctx, NewJSONStringValue(tc.new))
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expected, equal)
+		})
+	}
+}
+
+func TestJSONStringValue_ValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+	req := xattr.ValidateAttributeRequest{Path: path.Root("parameters")}
+
+	resp := &xattr.ValidateAttributeResponse{}
+	NewJSONStringValue(`{"type": "object"}`).ValidateAttribute(ctx, req, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	resp = &xattr.ValidateAttributeResponse{}
+	NewJSONStringValue(`{"type": }`).ValidateAttribute(ctx, req, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = &xattr.ValidateAttributeResponse{}
+	NewJSONStringUnknown().ValidateAttribute(ctx, req, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,206 @@
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBodyLength is the number of bytes of a body logged before it is truncated.
+const maxLoggedBodyLength = 4096
+
+// maxErrorBodyLength is the number of bytes of a body that is not an API
+// error kept in the error message before it is truncated.
+const maxErrorBodyLength = 512
+
+// redactedValue replaces secrets in logs.
+const redactedValue = "***"
+
+// apiKeyPattern matches API keys, such as sk-proj-... and sk-admin-...
+var apiKeyPattern = regexp.MustCompile(`\bsk-[A-Za-z0-9_-]+`)
+
+// loggingTransport logs the requests to the API and their responses at debug
+// level, with the keys masked, and adds the request ID of failed requests to
+// the error message, so that it shows in the diagnostics.
+type loggingTransport struct {
+	next    http.RoundTripper
+	secrets []string
+}
+
+func newLoggingTransport(next http.RoundTripper, secrets ...string) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t := &loggingTransport{
+		next: next,
+	}
+	for _, s := range secrets {
+		if s != "" {
+			t.secrets = append(t.secrets, s)
+		}
+	}
+	return t
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The request context carries the logger and fields of the Terraform operation.
+	ctx := req.Context()
+	fields := map[string]interface{}{
+		"http.method": req.Method,
+		"http.path":   req.URL.Path,
+	}
+	if req.URL.RawQuery != "" {
+		fields["http.query"] = req.URL.RawQuery
+	}
+
+	headers := map[string]string{}
+	for k := range req.Header {
+		headers[k] = t.redact(req.Header.Get(k))
+	}
+	if req.Header.Get("Authorization") != "" {
+		headers["Authorization"] = "Bearer " + redactedValue
+	}
+	requestFields := map[string]interface{}{"http.request.headers": headers}
+
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(b))
+		requestFields["http.request.body"] = t.redactBody(b)
+	}
+	tflog.Debug(ctx, "Sending OpenAI API request", fields, requestFields)
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	fields["http.duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		tflog.Debug(ctx, "OpenAI API request failed", fields, map[string]interface{}{"error": t.redact(err.Error())})
+		return nil, err
+	}
+
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	requestID := res.Header.Get("X-Request-Id")
+	fields["http.status_code"] = res.StatusCode
+	fields["http.request_id"] = requestID
+	tflog.Debug(ctx, "Received OpenAI API response", fields, map[string]interface{}{"http.response.body": t.redactBody(b)})
+
+	if res.StatusCode >= http.StatusBadRequest && requestID != "" {
+		b = withRequestID(b, res.StatusCode, requestID)
+		res.ContentLength = int64(len(b))
+		res.Header.Del("Content-Length")
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	return res, nil
+}
+
+// redact masks the keys in s.
+func (t *loggingTransport) redact(s string) string {
+	for _, secret := range t.secrets {
+		s = strings.ReplaceAll(s, secret, redactedValue)
+	}
+	return apiKeyPattern.ReplaceAllString(s, redactedValue)
+}
+
+// redactBody masks the keys in a body, including the api_key.value of service
+// accounts, and truncates it.
+func (t *loggingTransport) redactBody(b []byte) string {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if d.Decode(&v) == nil {
+		redactAPIKeyValues(v)
+		if redacted, err := json.Marshal(v); err == nil {
+			b = redacted
+		}
+	}
+
+	s := t.redact(string(b))
+	if len(s) > maxLoggedBodyLength {
+		s = strings.ToValidUTF8(s[:maxLoggedBodyLength], "") + "...(truncated)"
+	}
+	return s
+}
+
+// redactAPIKeyValues masks the value of the api_key objects in a decoded JSON body.
+func redactAPIKeyValues(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if key, ok := e.(map[string]interface{}); ok && k == "api_key" {
+				if _, ok := key["value"]; ok {
+					key["value"] = redactedValue
+				}
+			}
+			redactAPIKeyValues(e)
+		}
+	case []interface{}:
+		for _, e := range v {
+			redactAPIKeyValues(e)
+		}
+	}
+}
+
+// withRequestID adds the request ID to the message of an error response.
+// Bodies that are not API errors, such as the HTML page of a gateway error,
+// are replaced with an API error holding the start of the body and the ID.
+func withRequestID(b []byte, statusCode int, requestID string) []byte {
+	var body map[string]json.RawMessage
+	var apiError map[string]json.RawMessage
+	var message string
+	if json.Unmarshal(b, &body) != nil || json.Unmarshal(body["error"], &apiError) != nil || apiError == nil || json.Unmarshal(apiError["message"], &message) != nil {
+		return newRequestIDError(b, statusCode, requestID)
+	}
+
+	m, err := json.Marshal(fmt.Sprintf("%s (request ID: %s)", message, requestID))
+	if err != nil {
+		return b
+	}
+	apiError["message"] = m
+	if body["error"], err = json.Marshal(apiError); err != nil {
+		return b
+	}
+	withID, err := json.Marshal(body)
+	if err != nil {
+		return b
+	}
+	return withID
+}
+
+// newRequestIDError returns an API error body for a response body that is not one.
+func newRequestIDError(b []byte, statusCode int, requestID string) []byte {
+	message := http.StatusText(statusCode)
+	if s := strings.TrimSpace(string(b)); s != "" {
+		if len(s) > maxErrorBodyLength {
+			s = strings.ToValidUTF8(s[:maxErrorBodyLength], "") + "...(truncated)"
+		}
+		message = fmt.Sprintf("%s: %s", message, apiKeyPattern.ReplaceAllString(s, redactedValue))
+	}
+
+	withID, err := json.Marshal(map[string]interface{}{
+		"error": map[string]interface{}{
+			"message": fmt.Sprintf("%s (request ID: %s)", message, requestID),
+			"type":    "",
+			"param":   nil,
+			"code":    nil,
+		},
+	})
+	if err != nil {
+		return b
+	}
+	return withID
+}
@@ -0,0 +1,128 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		_, _ = w.Write([]byte(`{"id":"user-abc","api_key":{"id":"key_abc","value":"sk-svcacct-abc"}}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := &http.Client{Transport: newLoggingTransport(nil, "custom-secret")}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/organization/projects/proj_abc/service_accounts?limit=1", strings.NewReader(`{"name":"custom-secret"}`))
+	req.Header.Set("Authorization", "Bearer sk-proj-abc")
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(res.Body)
+	assert.Contains(t, string(body), "sk-svcacct-abc", "the response is not redacted for the provider")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "Sending OpenAI API request", entries[0]["@message"])
+	assert.Equal(t, "POST", entries[0]["http.method"])
+	assert.Equal(t, "/v1/organization/projects/proj_abc/service_accounts", entries[0]["http.path"])
+	assert.Equal(t, "limit=1", entries[0]["http.query"])
+	assert.Equal(t, `{"name":"***"}`, entries[0]["http.request.body"])
+	assert.Equal(t, "Bearer ***", entries[0]["http.request.headers"].(map[string]interface{})["Authorization"])
+
+	assert.Equal(t, "Received OpenAI API response", entries[1]["@message"])
+	assert.Equal(t, float64(200), entries[1]["http.status_code"])
+	assert.Equal(t, "req_123", entries[1]["http.request_id"])
+	assert.Contains(t, entries[1], "http.duration_ms")
+	assert.Equal(t, `{"api_key":{"id":"key_abc","value":"***"},"id":"user-abc"}`, entries[1]["http.response.body"])
+	assert.NotContains(t, output.String(), "sk-")
+}
+
+func TestLoggingTransport_RequestContext(t *testing.T) {
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "gpt-4o", "object": "model"}`))
+	})
+	client.HTTPClient.Transport = newLoggingTransport(nil)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = tflog.SetField(ctx, "tf_resource_type", "openai_model")
+
+	// Requests of the SDK and of the provider are logged with the fields of the caller.
+	_, err := client.WithContext(ctx).Models().RetrieveModel("gpt-4o")
+	assert.NoError(t, err)
+	assert.NoError(t, client.WithContext(ctx).do(http.MethodGet, "models/gpt-4o", nil, nil, nil))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+	for _, entry := range entries {
+		assert.Equal(t, "openai_model", entry["tf_resource_type"])
+	}
+}
+
+func TestLoggingTransport_ErrorRequestID(t *testing.T) {
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_456")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":{"message":"Rate limit reached","type":"requests","code":"rate_limit_exceeded","param":null}}`))
+	})
+	client.HTTPClient.Transport = newLoggingTransport(nil)
+
+	_, err := client.Models().RetrieveModel("gpt-4o")
+	apiError := GetOpenAIAPIError(err)
+	if assert.NotNil(t, apiError) {
+		assert.Equal(t, "Rate limit reached (request ID: req_456)", apiError.Message)
+		assert.Equal(t, "rate_limit_exceeded", apiError.Code)
+		assert.Equal(t, http.StatusTooManyRequests, apiError.HTTPStatusCode)
+	}
+
+	err = client.do(http.MethodGet, "organization/admin_api_keys", nil, nil, nil)
+	assert.ErrorContains(t, err, "(request ID: req_456)")
+}
+
+func TestLoggingTransport_TruncatesBody(t *testing.T) {
+	transport := &loggingTransport{}
+	body := transport.redactBody([]byte(strings.Repeat("a", maxLoggedBodyLength+1)))
+	assert.Equal(t, strings.Repeat("a", maxLoggedBodyLength)+"...(truncated)", body)
+}
+
+func TestWithRequestID(t *testing.T) {
+	assert.Equal(t, `{"error":{"code":429,"message":"Slow down (request ID: req_1)"}}`, string(withRequestID([]byte(`{"error":{"message":"Slow down","code":429}}`), http.StatusTooManyRequests, "req_1")))
+	assert.Equal(t, `{"error":{"code":null,"message":"Bad Gateway: \u003chtml\u003eupstream error\u003c/html\u003e (request ID: req_1)","param":null,"type":""}}`, string(withRequestID([]byte("<html>upstream error</html>\n"), http.StatusBadGateway, "req_1")))
+	assert.Equal(t, `{"error":{"code":null,"message":"Service Unavailable (request ID: req_1)","param":null,"type":""}}`, string(withRequestID(nil, http.StatusServiceUnavailable, "req_1")))
+	assert.Equal(t, `{"error":{"code":null,"message":"Not Found: {\"id\":\"file-abc\"} (request ID: req_1)","param":null,"type":""}}`, string(withRequestID([]byte(`{"id":"file-abc"}`), http.StatusNotFound, "req_1")))
+}
+
+func TestLoggingTransport_NonJSONErrorRequestID(t *testing.T) {
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("X-Request-Id", "req_789")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html><body>502 Bad Gateway</body></html>"))
+	})
+	client.HTTPClient.Transport = newLoggingTransport(nil)
+
+	_, err := client.Models().RetrieveModel("gpt-4o")
+	assert.ErrorContains(t, err, "(request ID: req_789)")
+	assert.ErrorContains(t, err, "502 Bad Gateway")
+	if apiError := GetOpenAIAPIError(err); assert.NotNil(t, apiError) {
+		assert.Equal(t, http.StatusBadGateway, apiError.HTTPStatusCode)
+	}
+
+	err = client.do(http.MethodGet, "organization/admin_api_keys", nil, nil, nil)
+	assert.ErrorContains(t, err, "(request ID: req_789)")
+}
@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -89,10 +88,10 @@ func (d *ModelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	model, err := d.client.Models().RetrieveModel(data.Id.ValueString())
+	model, err := d.client.WithContext(ctx).Models().RetrieveModel(data.Id.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read Model, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Model", err))
 		return
 	}
 
@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -49,6 +48,31 @@ func NewOpenAIFileModel(f *openai.File) OpenAIFileModel {
 	return NewOpenAIFileModelWithPath(f, f.Filename)
 }
 
+// OpenAIFileResourceModel describes the OpenAI file resource model.
+type OpenAIFileResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Bytes    types.Int64  `tfsdk:"bytes"`
+	Created  types.Int64  `tfsdk:"created"`
+	Filename types.String `tfsdk:"filename"`
+	Filepath types.String `tfsdk:"filepath"`
+	Content  types.String `tfsdk:"content"`
+	Object   types.String `tfsdk:"object"`
+	Purpose  types.String `tfsdk:"purpose"`
+}
+
+func NewOpenAIFileResourceModel(f *openai.File, filepath types.String, content types.String) OpenAIFileResourceModel {
+	return OpenAIFileResourceModel{
+		Id:       types.StringValue(f.Id),
+		Bytes:    types.Int64Value(f.Bytes),
+		Created:  types.Int64Value(f.CreatedAt),
+		Filename: types.StringValue(f.Filename),
+		Filepath: filepath,
+		Content:  content,
+		Object:   types.StringValue(f.Object),
+		Purpose:  types.StringValue(f.Purpose),
+	}
+}
+
 type OpenAIFineTuningJobModel struct {
 	Id             types.String `tfsdk:"id"`
 	Object         types.String `tfsdk:"object"`
@@ -129,9 +153,10 @@ type OpenAIFineTuningJobResourceModel struct {
 	TrainedTokens  types.Int64  `tfsdk:"trained_tokens"`
 	Suffix         types.String `tfsdk:"suffix"`
 	Wait           types.Bool   `tfsdk:"wait"`
+	PollInterval   types.Int64  `tfsdk:"poll_interval"`
 }
 
-func NewOpenAIFineTuningJobResourceModel(ft *openai.FineTuningJob, wait bool) OpenAIFineTuningJobResourceModel {
+func NewOpenAIFineTuningJobResourceModel(ft *openai.FineTuningJob, wait types.Bool, pollInterval types.Int64) OpenAIFineTuningJobResourceModel {
 	ctx := context.TODO()
 
 	ftJobModel := OpenAIFineTuningJobResourceModel{
@@ -146,7 +171,8 @@ func NewOpenAIFineTuningJobResourceModel(ft *openai.FineTuningJob, wait bool) Op
 		TrainingFile:   types.StringValue(ft.TrainingFile),
 		TrainedTokens:  types.Int64Value(ft.TrainedTokens),
 		Suffix:         types.StringValue(""),
-		Wait:           types.BoolValue(wait),
+		Wait:           wait,
+		PollInterval:   pollInterval,
 	}
 
 	if ft.ValidationFile != nil {
@@ -190,50 +216,54 @@ func (e OpenAIFineTuningJobHyperparamsModel) AttrTypes() map[string]attr.Type {
 }
 
 type OpenAIAssistantResourceModel struct {
-	Id             types.String                        `tfsdk:"id"`
-	Object         types.String                        `tfsdk:"object"`
-	CreatedAt      types.Int64                         `tfsdk:"created_at"`
-	Name           types.String                        `tfsdk:"name"`
-	Description    types.String                        `tfsdk:"description"`
-	Model          types.String                        `tfsdk:"model"`
-	Instructions   types.String                        `tfsdk:"instructions"`
-	Tools          types.List                          `tfsdk:"tools"`
-	ToolResources  *OpenAIAssistantToolResourcesModel  `tfsdk:"tool_resources"`
-	Metadata       types.Map                           `tfsdk:"metadata"`
-	Temperature    types.Float64                       `tfsdk:"temperature"`
-	TopP           types.Float64                       `tfsdk:"top_p"`
-	ResponseFormat *OpenAIAssistantResponseFormatModel `tfsdk:"response_format"`
+	Id              types.String                        `tfsdk:"id"`
+	Object          types.String                        `tfsdk:"object"`
+	CreatedAt       types.Int64                         `tfsdk:"created_at"`
+	Name            types.String                        `tfsdk:"name"`
+	Description     types.String                        `tfsdk:"description"`
+	Model           types.String                        `tfsdk:"model"`
+	Instructions    types.String                        `tfsdk:"instructions"`
+	Tools           types.List                          `tfsdk:"tools"`
+	ToolResources   *OpenAIAssistantToolResourcesModel  `tfsdk:"tool_resources"`
+	Metadata        types.Map                           `tfsdk:"metadata"`
+	Temperature     types.Float64                       `tfsdk:"temperature"`
+	TopP            types.Float64                       `tfsdk:"top_p"`
+	ReasoningEffort types.String                        `tfsdk:"reasoning_effort"`
+	ResponseFormat  *OpenAIAssistantResponseFormatModel `tfsdk:"response_format"`
 }
 
 func (e OpenAIAssistantResourceModel) AttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"id":             types.StringType,
-		"object":         types.StringType,
-		"created_at":     types.Int64Type,
-		"name":           types.StringType,
-		"description":    types.StringType,
-		"model":          types.StringType,
-		"instructions":   types.StringType,
-		"tools":          types.ListType{ElemType: types.ObjectType{AttrTypes: OpenAIAssistantToolModel{}.AttrTypes()}},
-		"tool_resources": types.ObjectType{AttrTypes: OpenAIAssistantToolResourcesModel{}.AttrTypes()},
-		"metadata":       types.MapType{ElemType: types.StringType},
-		"temperature":    types.Float64Type,
-		"top_p":          types.Float64Type,
+		"id":               types.StringType,
+		"object":           types.StringType,
+		"created_at":       types.Int64Type,
+		"name":             types.StringType,
+		"description":      types.StringType,
+		"model":            types.StringType,
+		"instructions":     types.StringType,
+		"tools":            types.ListType{ElemType: types.ObjectType{AttrTypes: OpenAIAssistantToolModel{}.AttrTypes()}},
+		"tool_resources":   types.ObjectType{AttrTypes: OpenAIAssistantToolResourcesModel{}.AttrTypes()},
+		"metadata":         types.MapType{ElemType: types.StringType},
+		"temperature":      types.Float64Type,
+		"top_p":            types.Float64Type,
+		"reasoning_effort": types.StringType,
 	}
 }
 
-func NewOpenAIAssistantResourceModel(ctx context.Context, assistant *openai.Assistant) (OpenAIAssistantResourceModel, diag.Diagnostics) {
+func NewOpenAIAssistantResourceModel(ctx context.Context, assistant *Assistant) (OpenAIAssistantResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	model := OpenAIAssistantResourceModel{}
 	model.Id = types.StringValue(assistant.Id)
 	model.Object = types.StringValue(assistant.Object)
+	model.CreatedAt = types.Int64Value(assistant.CreatedAt)
 	model.Name = types.StringPointerValue(assistant.Name)
 	model.Description = types.StringPointerValue(assistant.Description)
 	model.Model = types.StringValue(assistant.Model)
 	model.Instructions = types.StringPointerValue(assistant.Instructions)
-	model.Temperature = types.Float64Value(assistant.Temperature)
-	model.TopP = types.Float64Value(assistant.TopP)
+	model.Temperature = types.Float64PointerValue(assistant.Temperature)
+	model.TopP = types.Float64PointerValue(assistant.TopP)
+	model.ReasoningEffort = types.StringPointerValue(assistant.ReasoningEffort)
 
 	if len(assistant.MetaData) == 0 {
 		model.Metadata = types.MapNull(types.StringType)
@@ -250,14 +280,19 @@ func NewOpenAIAssistantResourceModel(ctx context.Context, assistant *openai.Assi
 				Type: types.StringValue(t.Type),
 			}
 
+			tool.FileSearch, diags = NewOpenAIAssistantToolFileSearchModel(ctx, t.FileSearch)
+			if diags.HasError() {
+				return model, diags
+			}
+
 			if t.Function != nil {
-				parameters, err := json.Marshal(t.Function.Parameters)
+				parameters, err := NewJSONStringValueFrom(t.Function.Parameters)
 				if err != nil {
 					return model, diags
 				}
 				f := OpenAIAssistantToolFunctionModel{
 					Name:       types.StringValue(t.Function.Name),
-					Parameters: types.StringValue(string(parameters)),
+					Parameters: parameters,
 				}
 				if t.Function.Description != nil {
 					f.Description = types.StringPointerValue(t.Function.Description)
@@ -272,28 +307,9 @@ func NewOpenAIAssistantResourceModel(ctx context.Context, assistant *openai.Assi
 		}
 	}
 
-	if assistant.ToolResources != nil && (assistant.ToolResources.CodeInterpreter != nil || assistant.ToolResources.FileSearch != nil) {
-		model.ToolResources = &OpenAIAssistantToolResourcesModel{}
-		if assistant.ToolResources.CodeInterpreter == nil {
-			model.ToolResources.CodeInterpreter = types.ObjectNull(OpenAIAssistantToolResourceCodeInterpreterModel{}.AttrTypes())
-		} else {
-			codeInterpreter := &OpenAIAssistantToolResourceCodeInterpreterModel{}
-			codeInterpreter.FileIDs, diags = types.ListValueFrom(ctx, types.StringType, assistant.ToolResources.CodeInterpreter.FileIDs)
-			if diags.HasError() {
-				return model, diags
-			}
-			model.ToolResources.CodeInterpreter, diags = types.ObjectValueFrom(ctx, OpenAIAssistantToolResourceCodeInterpreterModel{}.AttrTypes(), codeInterpreter)
-		}
-		if assistant.ToolResources.FileSearch == nil {
-			model.ToolResources.FileSearch = types.ObjectNull(OpenAIAssistantToolResourceFileSearchModel{}.AttrTypes())
-		} else {
-			fileSearch := &OpenAIAssistantToolResourceFileSearchModel{}
-			fileSearch.VectorStoreIDs, diags = types.ListValueFrom(ctx, types.StringType, assistant.ToolResources.FileSearch.VectorStoreIDs)
-			if diags.HasError() {
-				return model, diags
-			}
-			model.ToolResources.FileSearch, diags = types.ObjectValueFrom(ctx, OpenAIAssistantToolResourceFileSearchModel{}.AttrTypes(), fileSearch)
-		}
+	model.ToolResources, diags = NewOpenAIAssistantToolResourcesModel(ctx, assistant.ToolResources)
+	if diags.HasError() {
+		return model, diags
 	}
 	if assistant.ResponseFormat != nil && assistant.ResponseFormat.StringValue != "auto" {
 		model.ResponseFormat = &OpenAIAssistantResponseFormatModel{
@@ -301,13 +317,13 @@ func NewOpenAIAssistantResourceModel(ctx context.Context, assistant *openai.Assi
 		}
 		if assistant.ResponseFormat.JsonSchema != nil {
 
-			schema, err := json.Marshal(assistant.ResponseFormat.JsonSchema.Schema)
+			schema, err := NewJSONStringValueFrom(assistant.ResponseFormat.JsonSchema.Schema)
 			if err != nil {
 				return model, diags
 			}
 			model.ResponseFormat.JsonSchema = &OpenAIAssistantResponseJsonSchemaModel{
 				Name:   types.StringValue(assistant.ResponseFormat.JsonSchema.Name),
-				Schema: types.StringValue(string(schema)),
+				Schema: schema,
 				Strict: types.BoolValue(assistant.ResponseFormat.JsonSchema.Strict),
 			}
 			if assistant.ResponseFormat.JsonSchema.Description != nil {
@@ -322,18 +338,86 @@ func NewOpenAIAssistantResourceModel(ctx context.Context, assistant *openai.Assi
 	return model, diags
 }
 
+// OpenAIAssistantSourcedResourceModel is the openai_assistant resource model.
+// It adds the attributes used to load content from files, which only exist
+// in configuration and are never returned by the API.
+type OpenAIAssistantSourcedResourceModel struct {
+	OpenAIAssistantResourceModel
+	InstructionsFile types.String `tfsdk:"instructions_file"`
+	TemplateVars     types.Map    `tfsdk:"template_vars"`
+	SourceHash       types.String `tfsdk:"source_hash"`
+}
+
+func NewOpenAIAssistantSourcedResourceModel(ctx context.Context, assistant *Assistant) (OpenAIAssistantSourcedResourceModel, diag.Diagnostics) {
+	base, diags := NewOpenAIAssistantResourceModel(ctx, assistant)
+	return OpenAIAssistantSourcedResourceModel{
+		OpenAIAssistantResourceModel: base,
+		InstructionsFile:             types.StringNull(),
+		TemplateVars:                 types.MapNull(types.StringType),
+		SourceHash:                   types.StringNull(),
+	}, diags
+}
+
 type OpenAIAssistantToolModel struct {
-	Type     types.String                      `tfsdk:"type"`
-	Function *OpenAIAssistantToolFunctionModel `tfsdk:"function"`
+	Type       types.String                      `tfsdk:"type"`
+	Function   *OpenAIAssistantToolFunctionModel `tfsdk:"function"`
+	FileSearch types.Object                      `tfsdk:"file_search"`
 }
 
 func (e OpenAIAssistantToolModel) AttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"type":     types.StringType,
-		"function": types.ObjectType{AttrTypes: OpenAIAssistantToolFunctionModel{}.AttrTypes()},
+		"type":        types.StringType,
+		"function":    types.ObjectType{AttrTypes: OpenAIAssistantToolFunctionModel{}.AttrTypes()},
+		"file_search": types.ObjectType{AttrTypes: OpenAIAssistantToolFileSearchModel{}.AttrTypes()},
 	}
 }
 
+type OpenAIAssistantToolFileSearchModel struct {
+	MaxNumResults  types.Int64  `tfsdk:"max_num_results"`
+	RankingOptions types.Object `tfsdk:"ranking_options"`
+}
+
+func (e OpenAIAssistantToolFileSearchModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"max_num_results": types.Int64Type,
+		"ranking_options": types.ObjectType{AttrTypes: OpenAIAssistantToolFileSearchRankingOptionsModel{}.AttrTypes()},
+	}
+}
+
+type OpenAIAssistantToolFileSearchRankingOptionsModel struct {
+	Ranker         types.String  `tfsdk:"ranker"`
+	ScoreThreshold types.Float64 `tfsdk:"score_threshold"`
+}
+
+func (e OpenAIAssistantToolFileSearchRankingOptionsModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ranker":          types.StringType,
+		"score_threshold": types.Float64Type,
+	}
+}
+
+func NewOpenAIAssistantToolFileSearchModel(ctx context.Context, fileSearch *AssistantToolFileSearch) (types.Object, diag.Diagnostics) {
+	if fileSearch == nil {
+		return types.ObjectNull(OpenAIAssistantToolFileSearchModel{}.AttrTypes()), nil
+	}
+
+	var diags diag.Diagnostics
+	model := OpenAIAssistantToolFileSearchModel{
+		MaxNumResults:  types.Int64PointerValue(fileSearch.MaxNumResults),
+		RankingOptions: types.ObjectNull(OpenAIAssistantToolFileSearchRankingOptionsModel{}.AttrTypes()),
+	}
+	if fileSearch.RankingOptions != nil {
+		model.RankingOptions, diags = types.ObjectValueFrom(ctx, OpenAIAssistantToolFileSearchRankingOptionsModel{}.AttrTypes(), OpenAIAssistantToolFileSearchRankingOptionsModel{
+			Ranker:         types.StringPointerValue(fileSearch.RankingOptions.Ranker),
+			ScoreThreshold: types.Float64Value(fileSearch.RankingOptions.ScoreThreshold),
+		})
+		if diags.HasError() {
+			return types.ObjectNull(OpenAIAssistantToolFileSearchModel{}.AttrTypes()), diags
+		}
+	}
+	return types.ObjectValueFrom(ctx, OpenAIAssistantToolFileSearchModel{}.AttrTypes(), model)
+}
+
 type OpenAIAssistantToolResourcesModel struct {
 	CodeInterpreter types.Object `tfsdk:"code_interpreter"`
 	FileSearch      types.Object `tfsdk:"file_search"`
@@ -346,6 +430,41 @@ func (e OpenAIAssistantToolResourcesModel) AttrTypes() map[string]attr.Type {
 	}
 }
 
+// NewOpenAIAssistantToolResourcesModel flattens the tool resources of an assistant or thread.
+func NewOpenAIAssistantToolResourcesModel(ctx context.Context, toolResources *openai.AssistantToolResources) (*OpenAIAssistantToolResourcesModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if toolResources == nil || (toolResources.CodeInterpreter == nil && toolResources.FileSearch == nil) {
+		return nil, diags
+	}
+
+	model := &OpenAIAssistantToolResourcesModel{}
+	if toolResources.CodeInterpreter == nil {
+		model.CodeInterpreter = types.ObjectNull(OpenAIAssistantToolResourceCodeInterpreterModel{}.AttrTypes())
+	} else {
+		codeInterpreter := &OpenAIAssistantToolResourceCodeInterpreterModel{}
+		codeInterpreter.FileIDs, diags = types.ListValueFrom(ctx, types.StringType, toolResources.CodeInterpreter.FileIDs)
+		if diags.HasError() {
+			return model, diags
+		}
+		model.CodeInterpreter, diags = types.ObjectValueFrom(ctx, OpenAIAssistantToolResourceCodeInterpreterModel{}.AttrTypes(), codeInterpreter)
+		if diags.HasError() {
+			return model, diags
+		}
+	}
+	if toolResources.FileSearch == nil {
+		model.FileSearch = types.ObjectNull(OpenAIAssistantToolResourceFileSearchModel{}.AttrTypes())
+	} else {
+		fileSearch := &OpenAIAssistantToolResourceFileSearchModel{}
+		fileSearch.VectorStoreIDs, diags = types.ListValueFrom(ctx, types.StringType, toolResources.FileSearch.VectorStoreIDs)
+		if diags.HasError() {
+			return model, diags
+		}
+		model.FileSearch, diags = types.ObjectValueFrom(ctx, OpenAIAssistantToolResourceFileSearchModel{}.AttrTypes(), fileSearch)
+	}
+	return model, diags
+}
+
 type OpenAIAssistantToolResourceCodeInterpreterModel struct {
 	FileIDs types.List `tfsdk:"file_ids"`
 }
@@ -379,16 +498,18 @@ func (e OpenAIAssistantToolResourceFileSearchVectorStoresModel) AttrTypes() map[
 }
 
 type OpenAIAssistantToolFunctionModel struct {
-	Description types.String `tfsdk:"description"`
-	Name        types.String `tfsdk:"name"`
-	Parameters  types.String `tfsdk:"parameters"`
+	Description    types.String    `tfsdk:"description"`
+	Name           types.String    `tfsdk:"name"`
+	Parameters     JSONStringValue `tfsdk:"parameters"`
+	ParametersFile types.String    `tfsdk:"parameters_file"`
 }
 
 func (e OpenAIAssistantToolFunctionModel) AttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"description": types.StringType,
-		"name":        types.StringType,
-		"parameters":  types.StringType,
+		"description":     types.StringType,
+		"name":            types.StringType,
+		"parameters":      JSONStringType{},
+		"parameters_file": types.StringType,
 	}
 }
 
@@ -434,8 +555,7 @@ func NewOpenAIVectoreStoreModel(ctx context.Context, vs *openai.VectorStore, dat
 		Name:       types.StringValue(vs.Name),
 		UsageBytes: types.Int64Value(vs.UsageBytes),
 		// FileCounts:
-		Status: types.StringValue(vs.Status),
-		// ExpiresAfter:
+		Status:       types.StringValue(vs.Status),
 		ExpiresAt:    types.Int64Value(vs.ExpiresAt),
 		LastActiveAt: types.Int64Value(vs.LastActiveAt),
 	}
@@ -460,6 +580,13 @@ func NewOpenAIVectoreStoreModel(ctx context.Context, vs *openai.VectorStore, dat
 		}
 	}
 
+	if vs.ExpiresAfter.Anchor != "" {
+		model.ExpiresAfter = &OpenAIExpiresAfterModel{
+			Anchor: types.StringValue(vs.ExpiresAfter.Anchor),
+			Days:   types.Int64Value(vs.ExpiresAfter.Days),
+		}
+	}
+
 	if len(vs.Metadata) == 0 {
 		model.Metadata = types.MapNull(types.StringType)
 	} else {
@@ -512,17 +639,274 @@ func (e OpenAIAssistantResponseFormatModel) AttrTypes() map[string]attr.Type {
 }
 
 type OpenAIAssistantResponseJsonSchemaModel struct {
-	Description types.String `tfsdk:"description"`
-	Name        types.String `tfsdk:"name"`
-	Schema      types.String `tfsdk:"schema"`
-	Strict      types.Bool   `tfsdk:"strict"`
+	Description types.String    `tfsdk:"description"`
+	Name        types.String    `tfsdk:"name"`
+	Schema      JSONStringValue `tfsdk:"schema"`
+	Strict      types.Bool      `tfsdk:"strict"`
 }
 
 func (e OpenAIAssistantResponseJsonSchemaModel) AttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"description": types.StringType,
 		"name":        types.StringType,
-		"schema":      types.StringType,
+		"schema":      JSONStringType{},
 		"strict":      types.BoolType,
 	}
 }
+
+// OpenAIBatchModel describes the OpenAI batch model.
+type OpenAIBatchModel struct {
+	Id               types.String `tfsdk:"id"`
+	Object           types.String `tfsdk:"object"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	InputFileId      types.String `tfsdk:"input_file_id"`
+	CompletionWindow types.String `tfsdk:"completion_window"`
+	Status           types.String `tfsdk:"status"`
+	OutputFileId     types.String `tfsdk:"output_file_id"`
+	ErrorFileId      types.String `tfsdk:"error_file_id"`
+	CreatedAt        types.Int64  `tfsdk:"created_at"`
+	InProgressAt     types.Int64  `tfsdk:"in_progress_at"`
+	ExpiresAt        types.Int64  `tfsdk:"expires_at"`
+	FinalizingAt     types.Int64  `tfsdk:"finalizing_at"`
+	CompletedAt      types.Int64  `tfsdk:"completed_at"`
+	FailedAt         types.Int64  `tfsdk:"failed_at"`
+	ExpiredAt        types.Int64  `tfsdk:"expired_at"`
+	CancellingAt     types.Int64  `tfsdk:"cancelling_at"`
+	CancelledAt      types.Int64  `tfsdk:"cancelled_at"`
+	RequestCounts    types.Object `tfsdk:"request_counts"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+}
+
+func (e OpenAIBatchModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"object":            types.StringType,
+		"endpoint":          types.StringType,
+		"input_file_id":     types.StringType,
+		"completion_window": types.StringType,
+		"status":            types.StringType,
+		"output_file_id":    types.StringType,
+		"error_file_id":     types.StringType,
+		"created_at":        types.Int64Type,
+		"in_progress_at":    types.Int64Type,
+		"expires_at":        types.Int64Type,
+		"finalizing_at":     types.Int64Type,
+		"completed_at":      types.Int64Type,
+		"failed_at":         types.Int64Type,
+		"expired_at":        types.Int64Type,
+		"cancelling_at":     types.Int64Type,
+		"cancelled_at":      types.Int64Type,
+		"request_counts":    types.ObjectType{AttrTypes: OpenAIBatchRequestCountsModel{}.AttrTypes()},
+		"metadata":          types.MapType{ElemType: types.StringType},
+	}
+}
+
+func NewOpenAIBatchModel(ctx context.Context, batch *Batch) (OpenAIBatchModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := OpenAIBatchModel{
+		Id:               types.StringValue(batch.Id),
+		Object:           types.StringValue(batch.Object),
+		Endpoint:         types.StringValue(batch.Endpoint),
+		InputFileId:      types.StringValue(batch.InputFileId),
+		CompletionWindow: types.StringValue(batch.CompletionWindow),
+		Status:           types.StringValue(batch.Status),
+		OutputFileId:     types.StringPointerValue(batch.OutputFileId),
+		ErrorFileId:      types.StringPointerValue(batch.ErrorFileId),
+		CreatedAt:        types.Int64Value(batch.CreatedAt),
+		InProgressAt:     types.Int64PointerValue(batch.InProgressAt),
+		ExpiresAt:        types.Int64PointerValue(batch.ExpiresAt),
+		FinalizingAt:     types.Int64PointerValue(batch.FinalizingAt),
+		CompletedAt:      types.Int64PointerValue(batch.CompletedAt),
+		FailedAt:         types.Int64PointerValue(batch.FailedAt),
+		ExpiredAt:        types.Int64PointerValue(batch.ExpiredAt),
+		CancellingAt:     types.Int64PointerValue(batch.CancellingAt),
+		CancelledAt:      types.Int64PointerValue(batch.CancelledAt),
+	}
+
+	if batch.RequestCounts == nil {
+		model.RequestCounts = types.ObjectNull(OpenAIBatchRequestCountsModel{}.AttrTypes())
+	} else {
+		requestCounts := OpenAIBatchRequestCountsModel{
+			Total:     types.Int64Value(batch.RequestCounts.Total),
+			Completed: types.Int64Value(batch.RequestCounts.Completed),
+			Failed:    types.Int64Value(batch.RequestCounts.Failed),
+		}
+		model.RequestCounts, diags = types.ObjectValueFrom(ctx, OpenAIBatchRequestCountsModel{}.AttrTypes(), requestCounts)
+		if diags.HasError() {
+			return model, diags
+		}
+	}
+
+	if len(batch.Metadata) == 0 {
+		model.Metadata = types.MapNull(types.StringType)
+	} else {
+		model.Metadata, diags = types.MapValueFrom(ctx, types.StringType, batch.Metadata)
+	}
+
+	return model, diags
+}
+
+type OpenAIBatchResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Object           types.String `tfsdk:"object"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	InputFileId      types.String `tfsdk:"input_file_id"`
+	CompletionWindow types.String `tfsdk:"completion_window"`
+	Status           types.String `tfsdk:"status"`
+	OutputFileId     types.String `tfsdk:"output_file_id"`
+	ErrorFileId      types.String `tfsdk:"error_file_id"`
+	CreatedAt        types.Int64  `tfsdk:"created_at"`
+	InProgressAt     types.Int64  `tfsdk:"in_progress_at"`
+	ExpiresAt        types.Int64  `tfsdk:"expires_at"`
+	FinalizingAt     types.Int64  `tfsdk:"finalizing_at"`
+	CompletedAt      types.Int64  `tfsdk:"completed_at"`
+	FailedAt         types.Int64  `tfsdk:"failed_at"`
+	ExpiredAt        types.Int64  `tfsdk:"expired_at"`
+	CancellingAt     types.Int64  `tfsdk:"cancelling_at"`
+	CancelledAt      types.Int64  `tfsdk:"cancelled_at"`
+	RequestCounts    types.Object `tfsdk:"request_counts"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+	Wait             types.Bool   `tfsdk:"wait"`
+}
+
+func NewOpenAIBatchResourceModel(ctx context.Context, batch *Batch, wait types.Bool) (OpenAIBatchResourceModel, diag.Diagnostics) {
+	m, diags := NewOpenAIBatchModel(ctx, batch)
+	return OpenAIBatchResourceModel{
+		Id:               m.Id,
+		Object:           m.Object,
+		Endpoint:         m.Endpoint,
+		InputFileId:      m.InputFileId,
+		CompletionWindow: m.CompletionWindow,
+		Status:           m.Status,
+		OutputFileId:     m.OutputFileId,
+		ErrorFileId:      m.ErrorFileId,
+		CreatedAt:        m.CreatedAt,
+		InProgressAt:     m.InProgressAt,
+		ExpiresAt:        m.ExpiresAt,
+		FinalizingAt:     m.FinalizingAt,
+		CompletedAt:      m.CompletedAt,
+		FailedAt:         m.FailedAt,
+		ExpiredAt:        m.ExpiredAt,
+		CancellingAt:     m.CancellingAt,
+		CancelledAt:      m.CancelledAt,
+		RequestCounts:    m.RequestCounts,
+		Metadata:         m.Metadata,
+		Wait:             wait,
+	}, diags
+}
+
+type OpenAIBatchRequestCountsModel struct {
+	Total     types.Int64 `tfsdk:"total"`
+	Completed types.Int64 `tfsdk:"completed"`
+	Failed    types.Int64 `tfsdk:"failed"`
+}
+
+func (e OpenAIBatchRequestCountsModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"total":     types.Int64Type,
+		"completed": types.Int64Type,
+		"failed":    types.Int64Type,
+	}
+}
+
+type OpenAIThreadResourceModel struct {
+	Id            types.String                       `tfsdk:"id"`
+	Object        types.String                       `tfsdk:"object"`
+	CreatedAt     types.Int64                        `tfsdk:"created_at"`
+	Messages      []OpenAIThreadMessageModel         `tfsdk:"messages"`
+	ToolResources *OpenAIAssistantToolResourcesModel `tfsdk:"tool_resources"`
+	Metadata      types.Map                          `tfsdk:"metadata"`
+}
+
+type OpenAIThreadMessageModel struct {
+	Role        types.String                         `tfsdk:"role"`
+	Content     types.String                         `tfsdk:"content"`
+	Attachments []OpenAIThreadMessageAttachmentModel `tfsdk:"attachments"`
+}
+
+type OpenAIThreadMessageAttachmentModel struct {
+	FileId types.String `tfsdk:"file_id"`
+	Tools  types.List   `tfsdk:"tools"`
+}
+
+// NewOpenAIThreadResourceModel flattens a thread. The API does not return the
+// initial messages, so they are carried over from the plan or prior state.
+func NewOpenAIThreadResourceModel(ctx context.Context, thread *Thread, messages []OpenAIThreadMessageModel) (OpenAIThreadResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := OpenAIThreadResourceModel{
+		Id:        types.StringValue(thread.Id),
+		Object:    types.StringValue(thread.Object),
+		CreatedAt: types.Int64Value(thread.CreatedAt),
+		Messages:  messages,
+	}
+
+	if len(thread.MetaData) == 0 {
+		model.Metadata = types.MapNull(types.StringType)
+	} else {
+		model.Metadata, diags = types.MapValueFrom(ctx, types.StringType, thread.MetaData)
+		if diags.HasError() {
+			return model, diags
+		}
+	}
+
+	model.ToolResources, diags = NewOpenAIAssistantToolResourcesModel(ctx, thread.ToolResources)
+	return model, diags
+}
+
+type OpenAIAssistantRunResourceModel struct {
+	Id               types.String    `tfsdk:"id"`
+	AssistantId      types.String    `tfsdk:"assistant_id"`
+	Prompt           types.String    `tfsdk:"prompt"`
+	Triggers         types.Map       `tfsdk:"triggers"`
+	ExpectRegex      types.String    `tfsdk:"expect_regex"`
+	ExpectJSONSchema JSONStringValue `tfsdk:"expect_json_schema"`
+	ThreadId         types.String    `tfsdk:"thread_id"`
+	Status           types.String    `tfsdk:"status"`
+	Model            types.String    `tfsdk:"model"`
+	Output           types.String    `tfsdk:"output"`
+	Usage            types.Object    `tfsdk:"usage"`
+	CreatedAt        types.Int64     `tfsdk:"created_at"`
+	CompletedAt      types.Int64     `tfsdk:"completed_at"`
+}
+
+type OpenAIAssistantRunUsageModel struct {
+	PromptTokens     types.Int64 `tfsdk:"prompt_tokens"`
+	CompletionTokens types.Int64 `tfsdk:"completion_tokens"`
+	TotalTokens      types.Int64 `tfsdk:"total_tokens"`
+}
+
+func (e OpenAIAssistantRunUsageModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"prompt_tokens":     types.Int64Type,
+		"completion_tokens": types.Int64Type,
+		"total_tokens":      types.Int64Type,
+	}
+}
+
+// NewOpenAIAssistantRunResourceModel refreshes the run attributes of data.
+// The configuration attributes and output are kept as they are.
+func NewOpenAIAssistantRunResourceModel(ctx context.Context, run *Run, data OpenAIAssistantRunResourceModel) (OpenAIAssistantRunResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(run.Id)
+	data.ThreadId = types.StringValue(run.ThreadId)
+	data.Status = types.StringValue(run.Status)
+	data.Model = types.StringValue(run.Model)
+	data.CreatedAt = types.Int64Value(run.CreatedAt)
+	data.CompletedAt = types.Int64PointerValue(run.CompletedAt)
+
+	if run.Usage == nil {
+		data.Usage = types.ObjectNull(OpenAIAssistantRunUsageModel{}.AttrTypes())
+	} else {
+		usage := OpenAIAssistantRunUsageModel{
+			PromptTokens:     types.Int64Value(run.Usage.PromptTokens),
+			CompletionTokens: types.Int64Value(run.Usage.CompletionTokens),
+			TotalTokens:      types.Int64Value(run.Usage.TotalTokens),
+		}
+		data.Usage, diags = types.ObjectValueFrom(ctx, OpenAIAssistantRunUsageModel{}.AttrTypes(), usage)
+	}
+
+	return data, diags
+}
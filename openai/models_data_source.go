@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
@@ -64,10 +63,10 @@ func (d *ModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	models, err := d.client.Models().ListModels()
+	models, err := d.client.WithContext(ctx).Models().ListModels()
 
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read Models, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Models", err))
 		return
 	}
 
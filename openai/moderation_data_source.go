@@ -0,0 +1,235 @@
+package openai
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ModerationDataSource{}
+
+func NewModerationDataSource() datasource.DataSource {
+	return &ModerationDataSource{OpenAIDatasource: &OpenAIDatasource{}}
+}
+
+// ModerationDataSource defines the data source implementation.
+type ModerationDataSource struct {
+	*OpenAIDatasource
+}
+
+// ModerationDataSourceModel describes the data source data model.
+type ModerationDataSourceModel struct {
+	Id                types.String            `tfsdk:"id"`
+	Model             types.String            `tfsdk:"model"`
+	Input             types.List              `tfsdk:"input"`
+	ImageURLs         types.List              `tfsdk:"image_urls"`
+	Flagged           types.Bool              `tfsdk:"flagged"`
+	FlaggedCategories types.List              `tfsdk:"flagged_categories"`
+	Categories        types.Map               `tfsdk:"categories"`
+	CategoryScores    types.Map               `tfsdk:"category_scores"`
+	Results           []ModerationResultModel `tfsdk:"results"`
+}
+
+type ModerationResultModel struct {
+	Flagged        types.Bool `tfsdk:"flagged"`
+	Categories     types.Map  `tfsdk:"categories"`
+	CategoryScores types.Map  `tfsdk:"category_scores"`
+}
+
+func (d *ModerationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_moderation"
+}
+
+func (d *ModerationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Moderation data source. Classifies if text and image inputs are potentially harmful. " +
+			"Use `flagged` in a `precondition` or `check` block to stop an apply when managed prompts are flagged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier for the moderation request.",
+				Computed:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The content moderation model to use. Defaults to omni-moderation-latest, in which case the model version that classified the inputs is reported. Image inputs are only supported by omni-moderation models.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"input": schema.ListAttribute{
+				MarkdownDescription: "The texts to classify.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.AtLeastOneOf(path.MatchRoot("image_urls")),
+				},
+			},
+			"image_urls": schema.ListAttribute{
+				MarkdownDescription: "URLs, or base64 encoded data URLs, of images to classify.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"flagged": schema.BoolAttribute{
+				MarkdownDescription: "Whether any of the inputs is flagged.",
+				Computed:            true,
+			},
+			"flagged_categories": schema.ListAttribute{
+				MarkdownDescription: "The sorted names of the categories flagged for any of the inputs.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"categories": schema.MapAttribute{
+				MarkdownDescription: "Each category and whether it is flagged for any of the inputs.",
+				ElementType:         types.BoolType,
+				Computed:            true,
+			},
+			"category_scores": schema.MapAttribute{
+				MarkdownDescription: "Each category and the highest score predicted for it across the inputs.",
+				ElementType:         types.Float64Type,
+				Computed:            true,
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The individual moderation results. Text only requests return one result per input, requests with images return a single result.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"flagged": schema.BoolAttribute{
+							MarkdownDescription: "Whether any of the categories is flagged.",
+							Computed:            true,
+						},
+						"categories": schema.MapAttribute{
+							MarkdownDescription: "Each category and whether it is flagged.",
+							ElementType:         types.BoolType,
+							Computed:            true,
+						},
+						"category_scores": schema.MapAttribute{
+							MarkdownDescription: "Each category and the score predicted by the model.",
+							ElementType:         types.Float64Type,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ModerationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModerationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var texts, imageURLs []string
+	resp.Diagnostics.Append(data.Input.ElementsAs(ctx, &texts, false)...)
+	resp.Diagnostics.Append(data.ImageURLs.ElementsAs(ctx, &imageURLs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mReq := ModerationRequest{
+		Model: data.Model.ValueStringPointer(),
+		Input: texts,
+	}
+	// Only multi-modal models accept typed inputs, so plain text is sent as a list of strings.
+	if len(imageURLs) > 0 {
+		var inputs []ModerationInput
+		for i := range texts {
+			inputs = append(inputs, ModerationInput{Type: "text", Text: &texts[i]})
+		}
+		for _, u := range imageURLs {
+			inputs = append(inputs, ModerationInput{Type: "image_url", ImageURL: &ModerationImageURL{URL: u}})
+		}
+		mReq.Input = inputs
+	}
+
+	tflog.Info(ctx, "Creating Moderation...")
+	moderation, err := d.client.WithContext(ctx).Moderations().CreateModeration(&mReq)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create moderation", err))
+		return
+	}
+
+	model, diags := NewModerationDataSourceModel(ctx, moderation)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Input = data.Input
+	model.ImageURLs = data.ImageURLs
+	// The API reports the dated model version, keep the configured alias.
+	if !data.Model.IsNull() {
+		model.Model = data.Model
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// NewModerationDataSourceModel flattens a moderation, combining the results so
+// that a category is flagged, and scored, by the input that scores highest.
+func NewModerationDataSourceModel(ctx context.Context, moderation *Moderation) (ModerationDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	model := ModerationDataSourceModel{
+		Id:      types.StringValue(moderation.Id),
+		Model:   types.StringValue(moderation.Model),
+		Flagged: types.BoolValue(false),
+	}
+
+	categories := map[string]bool{}
+	scores := map[string]float64{}
+	for _, r := range moderation.Results {
+		result := ModerationResultModel{
+			Flagged: types.BoolValue(r.Flagged),
+		}
+		result.Categories, d = types.MapValueFrom(ctx, types.BoolType, r.Categories)
+		diags.Append(d...)
+		result.CategoryScores, d = types.MapValueFrom(ctx, types.Float64Type, r.CategoryScores)
+		diags.Append(d...)
+		model.Results = append(model.Results, result)
+
+		if r.Flagged {
+			model.Flagged = types.BoolValue(true)
+		}
+		for k, v := range r.Categories {
+			categories[k] = categories[k] || v
+		}
+		for k, v := range r.CategoryScores {
+			if s, ok := scores[k]; !ok || v > s {
+				scores[k] = v
+			}
+		}
+	}
+
+	flaggedCategories := []string{}
+	for k, v := range categories {
+		if v {
+			flaggedCategories = append(flaggedCategories, k)
+		}
+	}
+	sort.Strings(flaggedCategories)
+
+	model.FlaggedCategories, d = types.ListValueFrom(ctx, types.StringType, flaggedCategories)
+	diags.Append(d...)
+	model.Categories, d = types.MapValueFrom(ctx, types.BoolType, categories)
+	diags.Append(d...)
+	model.CategoryScores, d = types.MapValueFrom(ctx, types.Float64Type, scores)
+	diags.Append(d...)
+
+	return model, diags
+}
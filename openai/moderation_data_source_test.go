@@ -0,0 +1,122 @@
+package openai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccModerationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccModerationDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openai_moderation.test", "id"),
+					resource.TestCheckResourceAttr("data.openai_moderation.test", "flagged", "false"),
+					resource.TestCheckResourceAttr("data.openai_moderation.test", "results.#", "2"),
+					resource.TestCheckResourceAttrSet("data.openai_moderation.test", "category_scores.violence"),
+				),
+			},
+		},
+	})
+}
+
+const testAccModerationDataSourceConfig = `
+data "openai_moderation" "test" {
+	input = [
+		"You are a helpful assistant that answers billing questions.",
+		"Be polite and concise.",
+	]
+}
+`
+
+func newTestModerationConfig(input []string, imageURLs []string) ModerationDataSourceModel {
+	list := func(values []string) types.List {
+		if values == nil {
+			return types.ListNull(types.StringType)
+		}
+		var elems []attr.Value
+		for _, v := range values {
+			elems = append(elems, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	return ModerationDataSourceModel{
+		Id:                types.StringNull(),
+		Model:             types.StringNull(),
+		Input:             list(input),
+		ImageURLs:         list(imageURLs),
+		Flagged:           types.BoolNull(),
+		FlaggedCategories: types.ListNull(types.StringType),
+		Categories:        types.MapNull(types.BoolType),
+		CategoryScores:    types.MapNull(types.Float64Type),
+	}
+}
+
+func TestModerationDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	client, recorder := newRecordingTestClient(t, `{
+		"id": "modr-123",
+		"model": "omni-moderation-2024-09-26",
+		"results": [
+			{"flagged": false, "categories": {"violence": false, "harassment": false}, "category_scores": {"violence": 0.01, "harassment": 0.2}},
+			{"flagged": true, "categories": {"violence": true, "harassment": false}, "category_scores": {"violence": 0.9, "harassment": 0.1}}
+		]
+	}`)
+	d := &ModerationDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	config := newTestModerationConfig([]string{"hello", "goodbye"}, nil)
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	req := recorder.last(t)
+	assert.Equal(t, "/v1/moderations", req.Path)
+	assert.Equal(t, []interface{}{"hello", "goodbye"}, req.Body["input"])
+	assert.NotContains(t, req.Body, "model")
+
+	var state ModerationDataSourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "omni-moderation-2024-09-26", state.Model.ValueString())
+	assert.True(t, state.Flagged.ValueBool())
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("violence")}), state.FlaggedCategories)
+	assert.Equal(t, types.BoolValue(false), state.Categories.Elements()["harassment"])
+	assert.Equal(t, types.Float64Value(0.9), state.CategoryScores.Elements()["violence"])
+	assert.Equal(t, types.Float64Value(0.2), state.CategoryScores.Elements()["harassment"])
+	assert.Len(t, state.Results, 2)
+}
+
+func TestModerationDataSource_ReadImages(t *testing.T) {
+	ctx := context.Background()
+	client, recorder := newRecordingTestClient(t, `{"id": "modr-123", "model": "omni-moderation-latest", "results": [{"flagged": false, "categories": {}, "category_scores": {}}]}`)
+	d := &ModerationDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	config := newTestModerationConfig([]string{"caption"}, []string{"https://example.com/image.png"})
+	config.Model = types.StringValue("omni-moderation-latest")
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestDataSourceConfig(t, ctx, s, &config)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	req := recorder.last(t)
+	assert.Equal(t, "omni-moderation-latest", req.Body["model"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"type": "text", "text": "caption"},
+		map[string]interface{}{"type": "image_url", "image_url": map[string]interface{}{"url": "https://example.com/image.png"}},
+	}, req.Body["input"])
+
+	var state ModerationDataSourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.False(t, state.Flagged.ValueBool())
+	assert.Empty(t, state.FlaggedCategories.Elements())
+}
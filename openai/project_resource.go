@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/skyscrapr/openai-sdk-go/openai"
 )
@@ -45,6 +47,9 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the project. This appears in reporting.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"created_at": schema.Int64Attribute{
 				MarkdownDescription: "The Unix timestamp (in seconds) of when the project was created.",
@@ -78,9 +83,9 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		Name: data.Name.ValueStringPointer(),
 	}
 
-	project, err := r.client.Projects().CreateProject(&aReq)
+	project, err := r.client.WithContext(ctx).Projects().CreateProject(&aReq)
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create project", err))
 		return
 	}
 	tflog.Info(ctx, "Project created successfully")
@@ -100,9 +105,9 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Reading Project with id: %s", data.Id.ValueString()))
-	project, err := r.client.Projects().RetrieveProject(data.Id.ValueString())
+	project, err := r.client.WithContext(ctx).Projects().RetrieveProject(data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to retrieve project, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve project", err))
 		return
 	}
 
@@ -132,9 +137,9 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		Name: data.Name.ValueStringPointer(),
 	}
 
-	project, err := r.client.Projects().ModifyProject(state.Id.ValueString(), aReq)
+	project, err := r.client.WithContext(ctx).Projects().ModifyProject(state.Id.ValueString(), aReq)
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to modify project, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to modify project", err))
 		return
 	}
 	tflog.Info(ctx, "Project modified successfully")
@@ -156,14 +161,9 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	// Archive the project
 	tflog.Info(ctx, fmt.Sprintf("Archiving Project: %s", data.Id.ValueString()))
-	project, err := r.client.Projects().ArchiveProject(data.Id.ValueString())
+	project, err := r.client.WithContext(ctx).Projects().ArchiveProject(data.Id.ValueString())
 	if err != nil {
-		if err, ok := err.(*openai.APIError); ok {
-			fmt.Println("openai error:", err.Code)
-			// Or whatever other field(s) you need
-		}
-
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to archive project, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to archive project", err))
 		return
 	}
 	if project.Status != "archived" {
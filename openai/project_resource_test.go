@@ -4,12 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccProjectResource_tool_simple(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	projectResourceName := "openai_project.test"
 
 	resource.Test(t, resource.TestCase{
@@ -54,3 +54,24 @@ resource openai_project test {
 }
 `, rName)
 }
+
+func TestProjectResource_Lifecycle(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	l := newLifecycleTest(t, fake, "openai_project")
+
+	l.apply(map[string]interface{}{"name": "support"})
+	id := l.attr("id")
+	assert.Equal(t, "support", fake.get("projects", id)["name"])
+	assert.Equal(t, "active", l.attr("status"))
+	l.assertNoChanges(map[string]interface{}{"name": "support"})
+
+	l.apply(map[string]interface{}{"name": "support-renamed"})
+	assert.Equal(t, id, l.attr("id"))
+	assert.Equal(t, "support-renamed", fake.get("projects", id)["name"])
+	l.assertNoChanges(map[string]interface{}{"name": "support-renamed"})
+
+	l.importState(id)
+
+	l.destroy()
+	assert.Equal(t, "archived", fake.get("projects", id)["status"])
+}
@@ -0,0 +1,172 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skyscrapr/openai-sdk-go/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ProjectServiceAccountApiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ProjectServiceAccountApiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ProjectServiceAccountApiKeyEphemeralResource{}
+
+// projectServiceAccountPrivateKey is the private data key holding the service
+// account to delete when the ephemeral resource is closed.
+const projectServiceAccountPrivateKey = "service_account"
+
+func NewProjectServiceAccountApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ProjectServiceAccountApiKeyEphemeralResource{OpenAIEphemeralResource: &OpenAIEphemeralResource{}}
+}
+
+// ProjectServiceAccountApiKeyEphemeralResource defines the ephemeral resource implementation.
+type ProjectServiceAccountApiKeyEphemeralResource struct {
+	*OpenAIEphemeralResource
+}
+
+// ProjectServiceAccountApiKeyEphemeralModel describes the ephemeral resource data model.
+type ProjectServiceAccountApiKeyEphemeralModel struct {
+	ProjectId        types.String `tfsdk:"project_id"`
+	Name             types.String `tfsdk:"name"`
+	ServiceAccountId types.String `tfsdk:"service_account_id"`
+	Id               types.String `tfsdk:"id"`
+	Value            types.String `tfsdk:"value"`
+	CreatedAt        types.Int64  `tfsdk:"created_at"`
+}
+
+type projectServiceAccountPrivateData struct {
+	ProjectId string `json:"project_id"`
+	Id        string `json:"id"`
+}
+
+func (r *ProjectServiceAccountApiKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_service_account_api_key"
+}
+
+func (r *ProjectServiceAccountApiKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A short-lived project API key, which is never stored in plan or state. " +
+			"The API only issues keys when a service account is created, so a service account is created in the project when the ephemeral resource is opened, " +
+			"and deleted, revoking its key, when it is closed at the end of the Terraform run. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to create the key in.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the temporary service account.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"service_account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the temporary service account.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the API key.",
+				Computed:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the API key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"created_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) of when the API key was created.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectServiceAccountApiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ProjectServiceAccountApiKeyEphemeralModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating temporary Project Service Account...")
+	sa, err := r.client.WithContext(ctx).Projects().CreateProjectServiceAccount(data.ProjectId.ValueString(), &openai.ProjectServiceAccountRequest{
+		Name: data.Name.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create project service account", err))
+		return
+	}
+
+	if sa.ApiKey == nil {
+		resp.Diagnostics.AddError("Missing Service Account API Key", fmt.Sprintf("Project service account %s was created without an API key", sa.ID))
+		// Close is not called when Open fails, so the service account is deleted here.
+		resp.Diagnostics.Append(r.deleteProjectServiceAccount(ctx, data.ProjectId.ValueString(), sa.ID)...)
+		return
+	}
+
+	// Record the service account, so that it is deleted on close.
+	private, err := json.Marshal(projectServiceAccountPrivateData{ProjectId: data.ProjectId.ValueString(), Id: sa.ID})
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode private data, got error: %s", err))
+		resp.Diagnostics.Append(r.deleteProjectServiceAccount(ctx, data.ProjectId.ValueString(), sa.ID)...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, projectServiceAccountPrivateKey, private)...)
+
+	data.ServiceAccountId = types.StringValue(sa.ID)
+	data.Id = types.StringValue(sa.ApiKey.ID)
+	data.Value = types.StringValue(sa.ApiKey.Value)
+	data.CreatedAt = types.Int64Value(sa.ApiKey.CreatedAt)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ProjectServiceAccountApiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	b, diags := req.Private.GetKey(ctx, projectServiceAccountPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || b == nil {
+		return
+	}
+
+	var sa projectServiceAccountPrivateData
+	if err := json.Unmarshal(b, &sa); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode private data, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.deleteProjectServiceAccount(ctx, sa.ProjectId, sa.Id)...)
+}
+
+// deleteProjectServiceAccount deletes the temporary service account, which revokes its API key.
+func (r *ProjectServiceAccountApiKeyEphemeralResource) deleteProjectServiceAccount(ctx context.Context, projectId string, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting temporary Project Service Account: %s", id))
+	_, err := r.client.WithContext(ctx).Projects().DeleteProjectServiceAccount(projectId, id)
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Project Service Account does not exist")
+			return diags
+		}
+		diags.Append(NewOpenAIErrorDiagnostic(fmt.Sprintf("Unable to delete project service account %s, its API key is still valid", id), err))
+		return diags
+	}
+	tflog.Trace(ctx, "Project Service Account deleted successfully")
+	return diags
+}
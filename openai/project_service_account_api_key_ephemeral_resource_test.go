@@ -0,0 +1,141 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccProjectServiceAccountApiKeyEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServiceAccountApiKeyEphemeralResourceConfig,
+			},
+		},
+	})
+}
+
+const testAccProjectServiceAccountApiKeyEphemeralResourceConfig = `
+resource "openai_project" "test" {
+	name = "tf-acc-ephemeral-key"
+}
+
+ephemeral "openai_project_service_account_api_key" "test" {
+	project_id = openai_project.test.id
+	name       = "tf-acc-ephemeral-key"
+}
+
+provider "openai" {
+	alias   = "ephemeral"
+	api_key = ephemeral.openai_project_service_account_api_key.test.value
+}
+`
+
+// openTestProjectServiceAccountApiKey opens the ephemeral resource against a
+// server that creates the service account with createBody, and returns the
+// requests sent to the server.
+func openTestProjectServiceAccountApiKey(t *testing.T, createBody string) (tfprotov6.ProviderServer, *tfprotov6.OpenEphemeralResourceResponse, *[]string) {
+	ctx := context.Background()
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "POST":
+			w.Write([]byte(createBody))
+		case "DELETE":
+			w.Write([]byte(`{"object": "organization.project.service_account.deleted", "id": "svc_acct_abc", "deleted": true}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	providerServer, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unable to create provider server: %s", err)
+	}
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unable to get provider schema: %s", err)
+	}
+	providerConfig := dynamicValue(t, schemaResp.Provider.ValueType(), map[string]interface{}{"api_key": "test-api-key", "admin_key": "test-admin-key", "base_url": server.URL})
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	assert.NoError(t, err)
+	assert.Empty(t, configureResp.Diagnostics)
+
+	s := ephemeralResourceSchema(ctx, NewProjectServiceAccountApiKeyEphemeralResource())
+	config := dynamicValue(t, s.Type().TerraformType(ctx), map[string]interface{}{"project_id": "proj_abc", "name": "ci"})
+	openResp, err := providerServer.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "openai_project_service_account_api_key",
+		Config:   &config,
+	})
+	assert.NoError(t, err)
+	return providerServer, openResp, &requests
+}
+
+func TestProjectServiceAccountApiKeyEphemeralResource_OpenClose(t *testing.T) {
+	ctx := context.Background()
+	providerServer, openResp, requests := openTestProjectServiceAccountApiKey(t, `{
+		"object": "organization.project.service_account",
+		"id": "svc_acct_abc",
+		"name": "ci",
+		"role": "member",
+		"created_at": 1711471533,
+		"api_key": {"object": "organization.project.service_account.api_key", "value": "sk-proj-secret", "name": "Secret Key", "created_at": 1711471533, "id": "key_abc"}
+	}`)
+	assert.Empty(t, openResp.Diagnostics)
+
+	typ := ephemeralResourceSchema(ctx, NewProjectServiceAccountApiKeyEphemeralResource()).Type().TerraformType(ctx)
+	result, err := openResp.Result.Unmarshal(typ)
+	assert.NoError(t, err)
+	var attrs map[string]tftypes.Value
+	assert.NoError(t, result.As(&attrs))
+	var value, serviceAccountId string
+	assert.NoError(t, attrs["value"].As(&value))
+	assert.NoError(t, attrs["service_account_id"].As(&serviceAccountId))
+	assert.Equal(t, "sk-proj-secret", value)
+	assert.Equal(t, "svc_acct_abc", serviceAccountId)
+
+	closeResp, err := providerServer.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "openai_project_service_account_api_key",
+		Private:  openResp.Private,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, closeResp.Diagnostics)
+	assert.Equal(t, []string{
+		"POST /v1/organization/projects/proj_abc/service_accounts",
+		"DELETE /v1/organization/projects/proj_abc/service_accounts/svc_acct_abc",
+	}, *requests)
+}
+
+func TestProjectServiceAccountApiKeyEphemeralResource_OpenWithoutApiKey(t *testing.T) {
+	_, openResp, requests := openTestProjectServiceAccountApiKey(t, `{
+		"object": "organization.project.service_account",
+		"id": "svc_acct_abc",
+		"name": "ci",
+		"role": "member",
+		"created_at": 1711471533
+	}`)
+	if assert.Len(t, openResp.Diagnostics, 1) {
+		assert.Equal(t, tfprotov6.DiagnosticSeverityError, openResp.Diagnostics[0].Severity)
+		assert.Equal(t, "Missing Service Account API Key", openResp.Diagnostics[0].Summary)
+	}
+
+	// Close is not called when Open fails, so the service account is deleted straight away.
+	assert.Equal(t, []string{
+		"POST /v1/organization/projects/proj_abc/service_accounts",
+		"DELETE /v1/organization/projects/proj_abc/service_accounts/svc_acct_abc",
+	}, *requests)
+}
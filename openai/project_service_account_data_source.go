@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -89,10 +88,10 @@ func (d *ProjectServiceAccountDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
-	projectServiceAccount, err := d.client.Projects().RetrieveProjectServiceAccount(data.ProjectId.ValueString(), data.Id.ValueString())
+	projectServiceAccount, err := d.client.WithContext(ctx).Projects().RetrieveProjectServiceAccount(data.ProjectId.ValueString(), data.Id.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read ProjectServiceAccount, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read ProjectServiceAccount", err))
 		return
 	}
 
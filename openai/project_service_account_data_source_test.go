@@ -4,12 +4,11 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccProjectServiceAccountDataSource(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
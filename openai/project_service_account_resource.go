@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/skyscrapr/openai-sdk-go/openai"
@@ -32,7 +36,8 @@ func (r *ProjectServiceAccountResource) Metadata(ctx context.Context, req resour
 func (r *ProjectServiceAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Represents an individual project service account.",
+		MarkdownDescription: "Represents an individual project service account. " +
+			"The value of its API key is stored in state, use the `openai_project_service_account_api_key` ephemeral resource for keys that must not be stored.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -42,6 +47,9 @@ func (r *ProjectServiceAccountResource) Schema(ctx context.Context, req resource
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "The identifier, which can be referenced in API endpoints.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"object": schema.StringAttribute{
 				MarkdownDescription: "The object type, which is always organization.project.service_account",
@@ -50,11 +58,19 @@ func (r *ProjectServiceAccountResource) Schema(ctx context.Context, req resource
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the service account.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "owner or member",
-				Optional:            true,
+				MarkdownDescription: "The role of the service account, owner or member. Service accounts are always created with the member role.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.Int64Attribute{
 				MarkdownDescription: "The Unix timestamp (in seconds) of when the project was created.",
@@ -101,15 +117,15 @@ func (r *ProjectServiceAccountResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	tflog.Info(ctx, "Creating Assistant...")
+	tflog.Info(ctx, "Creating Project Service Account...")
 
 	aReq := openai.ProjectServiceAccountRequest{
 		Name: plan.Name.ValueStringPointer(),
 	}
 
-	projectServiceAccount, err := r.client.Projects().CreateProjectServiceAccount(plan.ProjectId.ValueString(), &aReq)
+	projectServiceAccount, err := r.client.WithContext(ctx).Projects().CreateProjectServiceAccount(plan.ProjectId.ValueString(), &aReq)
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to create project service account, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create project service account", err))
 		return
 	}
 	tflog.Info(ctx, "Project Service Account created successfully")
@@ -133,9 +149,9 @@ func (r *ProjectServiceAccountResource) Read(ctx context.Context, req resource.R
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Reading ProjectServiceAccount with id: %s", data.Id.ValueString()))
-	projectServiceAccount, err := r.client.Projects().RetrieveProjectServiceAccount(data.ProjectId.ValueString(), data.Id.ValueString())
+	projectServiceAccount, err := r.client.WithContext(ctx).Projects().RetrieveProjectServiceAccount(data.ProjectId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to retrieve project service account, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve project service account", err))
 		return
 	}
 
@@ -165,14 +181,9 @@ func (r *ProjectServiceAccountResource) Delete(ctx context.Context, req resource
 
 	// Delete the project service account
 	tflog.Info(ctx, fmt.Sprintf("Deleting Project Service Account: %s", data.Id.ValueString()))
-	bDeleted, err := r.client.Projects().DeleteProjectServiceAccount(data.ProjectId.ValueString(), data.Id.ValueString())
+	bDeleted, err := r.client.WithContext(ctx).Projects().DeleteProjectServiceAccount(data.ProjectId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		if err, ok := err.(*openai.APIError); ok {
-			fmt.Println("openai error:", err.Code)
-			// Or whatever other field(s) you need
-		}
-
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to delete project service account, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to delete project service account", err))
 		return
 	}
 	if !bDeleted {
@@ -5,12 +5,12 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccProjectServiceAccountResource_simple(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 	resourceName := "openai_project_service_account.test"
 
 	resource.Test(t, resource.TestCase{
@@ -54,3 +54,27 @@ resource openai_project_service_account test {
 }
 `, rName, rName)
 }
+
+func TestProjectServiceAccountResource_Lifecycle(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("projects", fakeObject{"id": "proj_abc", "object": "organization.project", "name": "support", "status": "active", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_project_service_account")
+
+	config := map[string]interface{}{"project_id": "proj_abc", "name": "ci"}
+	l.apply(config)
+	id := l.attr("id")
+	assert.Equal(t, "ci", fake.get("service_accounts", id)["name"])
+	assert.Equal(t, "member", l.attr("role"))
+	l.assertNoChanges(config)
+
+	// Renaming replaces the service account.
+	config["name"] = "deploy"
+	l.apply(config)
+	assert.Nil(t, fake.get("service_accounts", id))
+	assert.NotEqual(t, id, l.attr("id"))
+	id = l.attr("id")
+	l.assertNoChanges(config)
+
+	l.destroy()
+	assert.Nil(t, fake.get("service_accounts", id))
+}
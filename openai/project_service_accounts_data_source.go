@@ -2,7 +2,6 @@ package openai
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
@@ -69,10 +68,10 @@ func (d *ProjectServiceAccountsDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	projectServiceAccounts, err := d.client.Projects().ListProjectServiceAccounts(data.ProjectId.ValueString())
+	projectServiceAccounts, err := d.client.WithContext(ctx).Projects().ListProjectServiceAccounts(data.ProjectId.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("OpenAI Client Error", fmt.Sprintf("Unable to read Project Service Accounts, got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to read Project Service Accounts", err))
 		return
 	}
 
@@ -2,19 +2,27 @@ package openai
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/skyscrapr/openai-sdk-go/openai"
 )
 
 // Ensure OpenAIProvider satisfies various provider interfaces.
 var _ provider.Provider = &OpenAIProvider{}
+var _ provider.ProviderWithEphemeralResources = &OpenAIProvider{}
+var _ provider.ProviderWithFunctions = &OpenAIProvider{}
 
 // OpenAIProvider defines the provider implementation.
 type OpenAIProvider struct {
@@ -27,7 +35,11 @@ type OpenAIProvider struct {
 // OpenAIProviderModel describes the provider data model.
 type OpenAIProviderModel struct {
 	ApiKey         types.String `tfsdk:"api_key"`
+	ApiKeyFile     types.String `tfsdk:"api_key_file"`
+	ApiKeyCommand  types.List   `tfsdk:"api_key_command"`
 	AdminKey       types.String `tfsdk:"admin_key"`
+	AdminKeyFile   types.String `tfsdk:"admin_key_file"`
+	Profile        types.String `tfsdk:"profile"`
 	BaseURL        types.String `tfsdk:"base_url"`
 	OrganizationID types.String `tfsdk:"organization_id"`
 }
@@ -44,10 +56,43 @@ func (p *OpenAIProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:  true,
 				Sensitive: true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API key, e.g. a mounted secret. A leading `~` is expanded to the home directory.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key"), path.MatchRoot("api_key_command")),
+				},
+			},
+			"api_key_command": schema.ListAttribute{
+				MarkdownDescription: "Command, as the program followed by its arguments, that prints the API key to stdout, " +
+					"e.g. `[\"op\", \"read\", \"op://ci/openai/api-key\"]`. The key is cached for the lifetime of the provider, " +
+					"so the command runs once per Terraform operation.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+			},
 			"admin_key": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
+			"admin_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the admin key. A leading `~` is expanded to the home directory.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("admin_key")),
+				},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Profile of the credentials file to read `api_key`, `admin_key` and `base_url` from. " +
+					"The credentials file is `~/.openai/credentials`, or the file set with `OPENAI_CREDENTIALS_FILE`. " +
+					"Defaults to `OPENAI_PROFILE`, or the `default` profile. Keys set in the provider configuration take precedence, " +
+					"followed by a configured profile, the `OPENAI_API_KEY` and `OPENAI_ADMIN_KEY` environment variables, and a default profile. " +
+					"A default profile is only read when a key is not set otherwise.",
+				Optional: true,
+			},
 			"base_url": schema.StringAttribute{
 				Optional: true,
 			},
@@ -73,37 +118,82 @@ func (p *OpenAIProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	client := configureClient(data)
+	client, err := configureClient(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Configure OpenAI Client",
+			fmt.Sprintf("The provider cannot create the OpenAI API client, got error: %s", err),
+		)
+		return
+	}
 
 	// Make the OpenAI client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *OpenAIProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAssistantResource,
+		NewBatchResource,
 		NewFileResource,
 		NewFineTuningJobResource,
 		NewProjectResource,
 		NewVectorStoreResource,
 		NewProjectServiceAccountResource,
+		NewThreadResource,
+		NewAssistantRunResource,
+		NewAdminApiKeyResource,
 	}
 }
 
 func (p *OpenAIProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAdminApiKeysDataSource,
+		NewAssistantsDataSource,
+		NewAssistantDataSource,
+		NewAuditLogsDataSource,
+		NewBatchesDataSource,
+		NewBatchDataSource,
+		NewBatchInputDataSource,
+		NewChatCompletionDataSource,
+		NewCostsDataSource,
+		NewEmbeddingDataSource,
 		NewFilesDataSource,
 		NewFileDataSource,
 		NewFineTuningJobsDataSource,
 		NewFineTuningJobDataSource,
 		NewModelsDataSource,
 		NewModelDataSource,
+		NewModerationDataSource,
 		NewProjectsDataSource,
 		NewProjectDataSource,
 		NewProjectServiceAccountsDataSource,
 		NewProjectServiceAccountDataSource,
+		NewUsageCompletionsDataSource,
+		NewUsageEmbeddingsDataSource,
+		NewUsageModerationsDataSource,
+		NewUsageImagesDataSource,
+		NewUsageAudioSpeechesDataSource,
+		NewUsageAudioTranscriptionsDataSource,
+		NewUsageVectorStoresDataSource,
+		NewUsageCodeInterpreterSessionsDataSource,
+	}
+}
+
+func (p *OpenAIProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewProjectServiceAccountApiKeyEphemeralResource,
+	}
+}
+
+func (p *OpenAIProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCountTokensFunction,
+		NewEstimateCostFunction,
+		NewValidateJSONSchemaFunction,
 	}
 }
 
@@ -115,28 +205,86 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-func configureClient(data OpenAIProviderModel) *openai.Client {
-	api_key := os.Getenv("OPENAI_API_KEY")
-	if !data.ApiKey.IsNull() {
+func configureClient(ctx context.Context, data OpenAIProviderModel) (*OpenAIClient, error) {
+	// A profile set in the configuration takes precedence over the
+	// environment variables, the one set with OPENAI_PROFILE or the default
+	// profile does not.
+	profileName := data.Profile.ValueString()
+	explicitProfile := profileName != ""
+	if profileName == "" {
+		profileName = os.Getenv("OPENAI_PROFILE")
+	}
+	requiredProfile := profileName != ""
+	if profileName == "" {
+		profileName = defaultCredentialsProfile
+	}
+	// The default profile is only read when a key is not set otherwise, so that
+	// the credentials file cannot fail configurations that do not use it.
+	var profile map[string]string
+	var err error
+	apiKeyFallback := data.ApiKey.IsNull() && data.ApiKeyFile.IsNull() && data.ApiKeyCommand.IsNull() && os.Getenv("OPENAI_API_KEY") == ""
+	adminKeyFallback := data.AdminKey.IsNull() && data.AdminKeyFile.IsNull() && os.Getenv("OPENAI_ADMIN_KEY") == ""
+	if requiredProfile || apiKeyFallback || adminKeyFallback {
+		profile, err = loadCredentialsProfile(credentialsFilePath(), profileName)
+		if err != nil {
+			return nil, err
+		}
+		if profile == nil && requiredProfile {
+			return nil, fmt.Errorf("profile %q not found in credentials file %s", profileName, credentialsFilePath())
+		}
+	}
+
+	var api_key string
+	switch {
+	case !data.ApiKey.IsNull():
 		api_key = data.ApiKey.ValueString()
+	case !data.ApiKeyFile.IsNull():
+		if api_key, err = readKeyFile(data.ApiKeyFile.ValueString()); err != nil {
+			return nil, err
+		}
+	case !data.ApiKeyCommand.IsNull():
+		var args []string
+		if diags := data.ApiKeyCommand.ElementsAs(ctx, &args, false); diags.HasError() {
+			return nil, fmt.Errorf("api_key_command must be known when the provider is configured")
+		}
+		if api_key, err = runKeyCommand(ctx, args); err != nil {
+			return nil, err
+		}
+	default:
+		api_key = envOrProfile("OPENAI_API_KEY", profile["api_key"], explicitProfile)
 	}
 
-	admin_key := os.Getenv("OPENAI_ADMIN_KEY")
-	if !data.AdminKey.IsNull() {
+	var admin_key string
+	switch {
+	case !data.AdminKey.IsNull():
 		admin_key = data.AdminKey.ValueString()
+	case !data.AdminKeyFile.IsNull():
+		if admin_key, err = readKeyFile(data.AdminKeyFile.ValueString()); err != nil {
+			return nil, err
+		}
+	default:
+		admin_key = envOrProfile("OPENAI_ADMIN_KEY", profile["admin_key"], explicitProfile)
 	}
 
-	base_url := os.Getenv("OPENAI_BASE_URL")
+	base_url := envOrProfile("OPENAI_BASE_URL", profile["base_url"], explicitProfile)
 	if !data.BaseURL.IsNull() {
 		base_url = data.BaseURL.ValueString()
 	}
 
-	client := openai.NewClient(api_key, admin_key)
+	client := NewOpenAIClient(api_key, admin_key)
 	if base_url != "" {
-		if parsed, err := url.Parse(base_url); err == nil {
-			client.BaseURL = parsed
+		parsed, err := url.Parse(base_url)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base_url: %w", err)
 		}
+		client.BaseURL = parsed
+	}
+
+	transport, err := newRecordingTransport(os.Getenv("OPENAI_RECORD_MODE"), os.Getenv("OPENAI_CASSETTE"), client.HTTPClient.Transport, api_key, admin_key)
+	if err != nil {
+		return nil, err
 	}
+	client.HTTPClient.Transport = newLoggingTransport(transport, api_key, admin_key)
 
 	// organization_id := os.Getenv("OPENAI_ORGANIZATION_ID")
 	// if !data.OrganizationID.IsNull() {
@@ -144,5 +292,15 @@ func configureClient(data OpenAIProviderModel) *openai.Client {
 	// }
 	// client.OrganizationID = organization_id
 
-	return client
+	return client, nil
+}
+
+// envOrProfile returns the value of the environment variable, or the value
+// from the profile when the profile was configured or the variable is not set.
+func envOrProfile(env string, profileValue string, explicitProfile bool) string {
+	value := os.Getenv(env)
+	if profileValue != "" && (explicitProfile || value == "") {
+		value = profileValue
+	}
+	return value
 }
@@ -3,12 +3,14 @@ package openai
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -25,11 +27,41 @@ func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
+	testAccCassette(t)
+}
+
+// testAccCassette points the provider at the cassette of the test when
+// OPENAI_RECORD_MODE is record or replay. Tests without a cassette are skipped
+// when replaying.
+func testAccCassette(t *testing.T) {
+	mode := os.Getenv("OPENAI_RECORD_MODE")
+	if mode != recordModeRecord && mode != recordModeReplay {
+		return
+	}
+	path := filepath.Join("testdata", "cassettes", t.Name()+".json")
+	if mode == recordModeReplay {
+		if _, err := os.Stat(path); err != nil {
+			t.Skipf("no cassette recorded at %s. Skipping acceptance test in replay mode", path)
+		}
+	}
+	t.Setenv("OPENAI_CASSETTE", path)
+	t.Cleanup(func() { closeCassette(path) })
+}
+
+// testAccRandomName returns a random name with the prefix, or a fixed one when
+// recording or replaying so that the requests match the cassette.
+func testAccRandomName(prefix string) string {
+	switch os.Getenv("OPENAI_RECORD_MODE") {
+	case recordModeRecord, recordModeReplay:
+		return prefix + "recorded"
+	}
+	return acctest.RandomWithPrefix(prefix)
 }
 
 func testAccOpenAI(t *testing.T) {
-	// Use this to skip tests that might take a long time or cost too much
-	if os.Getenv("TF_ACC_OPENAI") == "" {
+	// Use this to skip tests that might take a long time or cost too much.
+	// Replaying a cassette costs nothing, so those tests run in replay mode.
+	if os.Getenv("TF_ACC_OPENAI") == "" && os.Getenv("OPENAI_RECORD_MODE") != recordModeReplay {
 		t.Skipf("env var TF_ACC_OPENAI not set. Skipping acceptance test due to cost or time")
 	}
 }
@@ -70,7 +102,8 @@ func TestConfigureClient_EnvOverride(t *testing.T) {
 		BaseURL:  types.StringNull(),
 	}
 
-	client := configureClient(data)
+	client, err := configureClient(context.Background(), data)
+	assert.NoError(t, err)
 	assert.Equal(t, "https://base-url", client.BaseURL.String())
 }
 
@@ -84,6 +117,125 @@ func TestConfigureClient_ConfigOverride(t *testing.T) {
 		BaseURL:  types.StringValue("https://base-url-from-config"),
 	}
 
-	client := configureClient(data)
+	client, err := configureClient(context.Background(), data)
+	assert.NoError(t, err)
 	assert.Equal(t, "https://base-url-from-config", client.BaseURL.String())
 }
+
+func TestConfigureClient_KeyFiles(t *testing.T) {
+	t.Setenv("OPENAI_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("OPENAI_API_KEY", "sk-from-env")
+	dir := t.TempDir()
+	apiKeyFile := filepath.Join(dir, "api_key")
+	adminKeyFile := filepath.Join(dir, "admin_key")
+	assert.NoError(t, os.WriteFile(apiKeyFile, []byte("sk-from-file\n"), 0o600))
+	assert.NoError(t, os.WriteFile(adminKeyFile, []byte("sk-admin-from-file\n"), 0o600))
+
+	client, err := configureClient(context.Background(), OpenAIProviderModel{
+		ApiKeyFile:   types.StringValue(apiKeyFile),
+		AdminKeyFile: types.StringValue(adminKeyFile),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "sk-from-file", client.apiKey)
+	assert.Equal(t, "sk-admin-from-file", client.adminKey)
+
+	_, err = configureClient(context.Background(), OpenAIProviderModel{
+		ApiKeyFile: types.StringValue(filepath.Join(dir, "missing")),
+	})
+	assert.ErrorContains(t, err, "unable to read key file")
+}
+
+func TestConfigureClient_KeyCommand(t *testing.T) {
+	t.Setenv("OPENAI_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	runs := filepath.Join(t.TempDir(), "runs")
+	command, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"sh", "-c", "echo run >> " + runs + "; echo sk-from-command"})
+
+	for i := 0; i < 2; i++ {
+		client, err := configureClient(context.Background(), OpenAIProviderModel{ApiKeyCommand: command})
+		assert.NoError(t, err)
+		assert.Equal(t, "sk-from-command", client.apiKey)
+	}
+	b, err := os.ReadFile(runs)
+	assert.NoError(t, err)
+	assert.Equal(t, "run\n", string(b), "the command output is cached")
+
+	failing, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"sh", "-c", "echo locked >&2; exit 1"})
+	_, err = configureClient(context.Background(), OpenAIProviderModel{ApiKeyCommand: failing})
+	assert.ErrorContains(t, err, "key command sh failed")
+	assert.ErrorContains(t, err, "locked")
+}
+
+func TestConfigureClient_Profile(t *testing.T) {
+	credentials := filepath.Join(t.TempDir(), "credentials")
+	assert.NoError(t, os.WriteFile(credentials, []byte(`# OpenAI credentials
+[default]
+api_key = sk-default
+admin_key = sk-admin-default
+
+[prod]
+api_key = sk-prod
+base_url = https://prod.example.com
+`), 0o600))
+	t.Setenv("OPENAI_CREDENTIALS_FILE", credentials)
+	t.Setenv("OPENAI_API_KEY", "")
+	t.Setenv("OPENAI_ADMIN_KEY", "")
+	t.Setenv("OPENAI_BASE_URL", "")
+
+	client, err := configureClient(context.Background(), OpenAIProviderModel{})
+	assert.NoError(t, err)
+	assert.Equal(t, "sk-default", client.apiKey)
+	assert.Equal(t, "sk-admin-default", client.adminKey)
+
+	// The environment variables take precedence over the default profile.
+	t.Setenv("OPENAI_API_KEY", "sk-from-env")
+	client, err = configureClient(context.Background(), OpenAIProviderModel{})
+	assert.NoError(t, err)
+	assert.Equal(t, "sk-from-env", client.apiKey)
+	assert.Equal(t, "sk-admin-default", client.adminKey)
+
+	t.Setenv("OPENAI_PROFILE", "prod")
+	client, err = configureClient(context.Background(), OpenAIProviderModel{})
+	assert.NoError(t, err)
+	assert.Equal(t, "sk-from-env", client.apiKey)
+	assert.Equal(t, "https://prod.example.com", client.BaseURL.String())
+
+	// A configured profile takes precedence over the environment variables.
+	client, err = configureClient(context.Background(), OpenAIProviderModel{Profile: types.StringValue("prod")})
+	assert.NoError(t, err)
+	assert.Equal(t, "sk-prod", client.apiKey)
+	assert.Equal(t, "", client.adminKey)
+
+	_, err = configureClient(context.Background(), OpenAIProviderModel{Profile: types.StringValue("staging")})
+	assert.ErrorContains(t, err, `profile "staging" not found`)
+}
+
+func TestConfigureClient_InvalidProfile(t *testing.T) {
+	credentials := filepath.Join(t.TempDir(), "credentials")
+	assert.NoError(t, os.WriteFile(credentials, []byte("[default]\napi_key\n"), 0o600))
+	t.Setenv("OPENAI_CREDENTIALS_FILE", credentials)
+	t.Setenv("OPENAI_PROFILE", "")
+	t.Setenv("OPENAI_API_KEY", "")
+	t.Setenv("OPENAI_ADMIN_KEY", "")
+	t.Setenv("OPENAI_BASE_URL", "")
+
+	// The default profile is not read when the keys are set otherwise.
+	keyFile := filepath.Join(t.TempDir(), "admin_key")
+	assert.NoError(t, os.WriteFile(keyFile, []byte("sk-admin-file\n"), 0o600))
+	client, err := configureClient(context.Background(), OpenAIProviderModel{ApiKey: types.StringValue("sk-config"), AdminKeyFile: types.StringValue(keyFile)})
+	assert.NoError(t, err)
+	assert.Equal(t, "sk-config", client.apiKey)
+	assert.Equal(t, "sk-admin-file", client.adminKey)
+
+	t.Setenv("OPENAI_API_KEY", "sk-from-env")
+	_, err = configureClient(context.Background(), OpenAIProviderModel{})
+	assert.ErrorContains(t, err, "unable to parse credentials file")
+
+	_, err = configureClient(context.Background(), OpenAIProviderModel{ApiKey: types.StringValue("sk-config"), AdminKey: types.StringValue("sk-admin-config"), Profile: types.StringValue("default")})
+	assert.ErrorContains(t, err, "unable to parse credentials file")
+}
+
+func TestConfigureClient_InvalidBaseURL(t *testing.T) {
+	t.Setenv("OPENAI_PROFILE", "")
+	_, err := configureClient(context.Background(), OpenAIProviderModel{ApiKey: types.StringValue("sk-config"), AdminKey: types.StringValue("sk-admin-config"), BaseURL: types.StringValue("http://[::1")})
+	assert.ErrorContains(t, err, "invalid base_url")
+}
@@ -0,0 +1,248 @@
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Modes of the OPENAI_RECORD_MODE environment variable. Record sends requests
+// to the API and saves the interactions to the cassette at OPENAI_CASSETTE,
+// replay answers requests from the cassette without network access, and
+// passthrough, the default, sends requests to the API as usual.
+const (
+	recordModeRecord      = "record"
+	recordModeReplay      = "replay"
+	recordModePassthrough = "passthrough"
+)
+
+// Keys and organization IDs are redacted from the cassettes, so that they can be committed.
+var cassetteOrgIDPattern = regexp.MustCompile(`\borg-[A-Za-z0-9]+`)
+
+// cassetteResponseHeaders are the response headers kept in the cassettes.
+var cassetteResponseHeaders = []string{"Content-Type", "X-Request-Id"}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body"`
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+// cassette holds the interactions recorded to or replayed from a file.
+type cassette struct {
+	mu           sync.Mutex
+	path         string
+	Interactions []*cassetteInteraction `json:"interactions"`
+	replayed     []bool
+}
+
+// cassettes are shared by path, as the provider is configured again for every
+// Terraform command run by a test.
+var cassettes = struct {
+	sync.Mutex
+	m map[string]*cassette
+}{m: map[string]*cassette{}}
+
+// openCassette returns the cassette at path, which starts empty when recording.
+func openCassette(path string, mode string) (*cassette, error) {
+	cassettes.Lock()
+	defer cassettes.Unlock()
+
+	if c, ok := cassettes.m[path]; ok {
+		return c, nil
+	}
+	c := &cassette{path: path}
+	if mode == recordModeReplay {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cassette: %w", err)
+		}
+		if err := json.Unmarshal(b, c); err != nil {
+			return nil, fmt.Errorf("unable to decode cassette %s: %w", path, err)
+		}
+		c.replayed = make([]bool, len(c.Interactions))
+	}
+	cassettes.m[path] = c
+	return c, nil
+}
+
+// closeCassette forgets the cassette at path, so that it is read or recorded
+// afresh when opened again.
+func closeCassette(path string) {
+	cassettes.Lock()
+	defer cassettes.Unlock()
+	delete(cassettes.m, path)
+}
+
+// add records an interaction and saves the cassette.
+func (c *cassette) add(i *cassetteInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, i)
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(b, '\n'), 0o644)
+}
+
+// next returns the first interaction not replayed yet for the request, so
+// that repeated requests such as polling get their responses in order.
+func (c *cassette) next(method string, url string) (*cassetteInteraction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for n, i := range c.Interactions {
+		if !c.replayed[n] && i.Request.Method == method && i.Request.URL == url {
+			c.replayed[n] = true
+			return i, nil
+		}
+	}
+	return nil, fmt.Errorf("no interaction left in cassette %s for %s %s", c.path, method, url)
+}
+
+// recordingTransport records the interactions with the API to a cassette or
+// replays them from it.
+type recordingTransport struct {
+	mode     string
+	cassette *cassette
+	next     http.RoundTripper
+	secrets  []string
+}
+
+// newRecordingTransport returns the transport for the record mode, or next
+// when the requests are to be passed through.
+func newRecordingTransport(mode string, path string, next http.RoundTripper, secrets ...string) (http.RoundTripper, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	switch mode {
+	case "", recordModePassthrough:
+		return next, nil
+	case recordModeRecord, recordModeReplay:
+	default:
+		return nil, fmt.Errorf("invalid OPENAI_RECORD_MODE %q, expected %s, %s or %s", mode, recordModeRecord, recordModeReplay, recordModePassthrough)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("OPENAI_CASSETTE must be set to the cassette file when OPENAI_RECORD_MODE is %s", mode)
+	}
+
+	c, err := openCassette(path, mode)
+	if err != nil {
+		return nil, err
+	}
+	t := &recordingTransport{
+		mode:     mode,
+		cassette: c,
+		next:     next,
+	}
+	for _, s := range secrets {
+		if s != "" {
+			t.secrets = append(t.secrets, s)
+		}
+	}
+	return t, nil
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The host is left out, so that cassettes replay against any base URL.
+	url := req.URL.RequestURI()
+
+	if t.mode == recordModeReplay {
+		i, err := t.cassette.next(req.Method, t.sanitize(url))
+		if err != nil {
+			return nil, err
+		}
+		res := &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{},
+			Body:          io.NopCloser(strings.NewReader(i.Response.Body)),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}
+		for k, v := range i.Response.Headers {
+			res.Header.Set(k, v)
+		}
+		return res, nil
+	}
+
+	var reqBody []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = b
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(b))
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	i := &cassetteInteraction{
+		Request: cassetteRequest{
+			Method: req.Method,
+			URL:    t.sanitize(url),
+			Body:   t.sanitize(string(reqBody)),
+		},
+		Response: cassetteResponse{
+			StatusCode: res.StatusCode,
+			Headers:    map[string]string{},
+			Body:       t.sanitize(string(resBody)),
+		},
+	}
+	for _, k := range cassetteResponseHeaders {
+		if v := res.Header.Get(k); v != "" {
+			i.Response.Headers[k] = t.sanitize(v)
+		}
+	}
+	if err := t.cassette.add(i); err != nil {
+		return nil, fmt.Errorf("unable to record cassette: %w", err)
+	}
+	return res, nil
+}
+
+// sanitize redacts the keys and organization IDs in s.
+func (t *recordingTransport) sanitize(s string) string {
+	for _, secret := range t.secrets {
+		s = strings.ReplaceAll(s, secret, "REDACTED")
+	}
+	s = apiKeyPattern.ReplaceAllString(s, "sk-REDACTED")
+	return cassetteOrgIDPattern.ReplaceAllString(s, "org-REDACTED")
+}
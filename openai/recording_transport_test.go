@@ -0,0 +1,77 @@
+package openai
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordingTransport_RecordReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "TestRecordingTransport_RecordReplay.json")
+	t.Cleanup(func() { closeCassette(path) })
+	t.Setenv("OPENAI_CASSETTE", path)
+	config := map[string]interface{}{"project_id": "proj_abc", "name": "ci"}
+
+	fake := newFakeOpenAI(t)
+	fake.put("projects", fakeObject{"id": "proj_abc", "object": "organization.project", "name": "support", "status": "active", "created_at": 1700000000})
+	t.Setenv("OPENAI_RECORD_MODE", recordModeRecord)
+	l := newLifecycleTest(t, fake, "openai_project_service_account")
+	l.apply(config)
+	l.assertNoChanges(config)
+	l.destroy()
+
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), "sk-REDACTED")
+	assert.NotContains(t, string(b), "sk-svcacct-fake")
+	assert.NotContains(t, string(b), fakeAdminKey)
+
+	// Replaying needs no server.
+	closeCassette(path)
+	fake.Close()
+	requests := len(fake.requests)
+	t.Setenv("OPENAI_RECORD_MODE", recordModeReplay)
+	l = newLifecycleTest(t, fake, "openai_project_service_account")
+	l.apply(config)
+	l.assertNoChanges(config)
+	l.destroy()
+	assert.Len(t, fake.requests, requests)
+
+	// Every recorded interaction has been replayed.
+	req, _ := http.NewRequest(http.MethodGet, fake.URL+"/v1/organization/projects/proj_abc/service_accounts", nil)
+	transport, err := newRecordingTransport(recordModeReplay, path, nil)
+	assert.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	assert.ErrorContains(t, err, "no interaction left in cassette")
+}
+
+func TestRecordingTransport_Passthrough(t *testing.T) {
+	for _, mode := range []string{"", recordModePassthrough} {
+		transport, err := newRecordingTransport(mode, "", http.DefaultTransport)
+		assert.NoError(t, err)
+		assert.Equal(t, http.DefaultTransport, transport)
+	}
+}
+
+func TestRecordingTransport_InvalidConfig(t *testing.T) {
+	_, err := newRecordingTransport("rewind", "cassette.json", nil)
+	assert.ErrorContains(t, err, `invalid OPENAI_RECORD_MODE "rewind"`)
+
+	_, err = newRecordingTransport(recordModeRecord, "", nil)
+	assert.ErrorContains(t, err, "OPENAI_CASSETTE must be set")
+
+	_, err = newRecordingTransport(recordModeReplay, filepath.Join(t.TempDir(), "missing.json"), nil)
+	assert.ErrorContains(t, err, "unable to read cassette")
+}
+
+func TestRecordingTransport_Sanitize(t *testing.T) {
+	transport := &recordingTransport{secrets: []string{"custom-secret"}}
+
+	assert.Equal(t, `{"value":"sk-REDACTED"}`, transport.sanitize(`{"value":"sk-proj-abc_123-XYZ"}`))
+	assert.Equal(t, `{"organization":"org-REDACTED"}`, transport.sanitize(`{"organization":"org-a1B2c3"}`))
+	assert.Equal(t, "Bearer REDACTED", transport.sanitize("Bearer custom-secret"))
+	assert.Equal(t, `{"id":"task-123","name":"disk-usage"}`, transport.sanitize(`{"id":"task-123","name":"disk-usage"}`))
+}
@@ -0,0 +1,159 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordedRequest is a request captured by the fake API.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Body   map[string]interface{}
+}
+
+// requestRecorder is a fake API that records each request and replies with a fixed body.
+type requestRecorder struct {
+	mu       sync.Mutex
+	requests []recordedRequest
+}
+
+func newRecordingTestClient(t *testing.T, response string) (*OpenAIClient, *requestRecorder) {
+	recorder := &requestRecorder{}
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rr := recordedRequest{Method: r.Method, Path: r.URL.Path}
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if len(b) > 0 {
+			assert.NoError(t, json.Unmarshal(b, &rr.Body))
+		}
+		recorder.mu.Lock()
+		recorder.requests = append(recorder.requests, rr)
+		recorder.mu.Unlock()
+		w.Write([]byte(response))
+	})
+	return client, recorder
+}
+
+// last returns the most recently recorded request.
+func (r *requestRecorder) last(t *testing.T) recordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.requests) == 0 {
+		t.Fatal("no requests were recorded")
+	}
+	return r.requests[len(r.requests)-1]
+}
+
+func resourceSchema(ctx context.Context, r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func dataSourceSchema(ctx context.Context, d datasource.DataSource) dsschema.Schema {
+	var resp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func ephemeralResourceSchema(ctx context.Context, r ephemeral.EphemeralResource) ephemeralschema.Schema {
+	var resp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// dynamicValue encodes a Go value, as accepted by tfValue, for a protocol request.
+func dynamicValue(t *testing.T, typ tftypes.Type, v interface{}) tfprotov6.DynamicValue {
+	value, err := tfValue(typ, v)
+	if err != nil {
+		t.Fatalf("unable to build value: %s", err)
+	}
+	dv, err := tfprotov6.NewDynamicValue(typ, value)
+	if err != nil {
+		t.Fatalf("unable to encode value: %s", err)
+	}
+	return dv
+}
+
+// newTestDataSourceConfig returns a data source configuration for the schema populated from model.
+func newTestDataSourceConfig(t *testing.T, ctx context.Context, s dsschema.Schema, model interface{}) tfsdk.Config {
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, model)
+	if diags.HasError() {
+		t.Fatalf("unable to set config: %v", diags)
+	}
+	return tfsdk.Config{Schema: s, Raw: state.Raw}
+}
+
+// newTestDataSourceState returns an empty data source state for the schema.
+func newTestDataSourceState(ctx context.Context, s dsschema.Schema) tfsdk.State {
+	return tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+}
+
+// newTestPlan returns a plan for the schema populated from model.
+func newTestPlan(t *testing.T, ctx context.Context, s schema.Schema, model interface{}) tfsdk.Plan {
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, model)
+	if diags.HasError() {
+		t.Fatalf("unable to set plan: %v", diags)
+	}
+	return plan
+}
+
+// newTestConfig returns a configuration for the schema populated from model.
+func newTestConfig(t *testing.T, ctx context.Context, s schema.Schema, model interface{}) tfsdk.Config {
+	plan := newTestPlan(t, ctx, s, model)
+	return tfsdk.Config{Schema: s, Raw: plan.Raw}
+}
+
+// newTestState returns a state for the schema populated from model, or an
+// empty state when model is nil.
+func newTestState(t *testing.T, ctx context.Context, s schema.Schema, model interface{}) tfsdk.State {
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if model == nil {
+		return state
+	}
+	diags := state.Set(ctx, model)
+	if diags.HasError() {
+		t.Fatalf("unable to set state: %v", diags)
+	}
+	return state
+}
+
+// assertRequestCoversSchema checks that every configurable top-level attribute
+// of the schema is sent in the request body, so an attribute can never be
+// accepted in configuration but silently dropped.
+func assertRequestCoversSchema(t *testing.T, s schema.Schema, body map[string]interface{}, ignore ...string) {
+	ignored := map[string]bool{}
+	for _, name := range ignore {
+		ignored[name] = true
+	}
+	var names []string
+	for name, a := range s.Attributes {
+		if (a.IsOptional() || a.IsRequired()) && !ignored[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		_, ok := body[name]
+		assert.Truef(t, ok, "attribute %q was not sent in the request body", name)
+	}
+}
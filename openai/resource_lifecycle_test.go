@@ -0,0 +1,325 @@
+package openai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// lifecycleTest drives a resource through the provider server the way Terraform
+// does, with the provider base_url pointed at a fake API, so that create,
+// update, import and destroy can be tested without network access or keys.
+type lifecycleTest struct {
+	t        *testing.T
+	ctx      context.Context
+	server   tfprotov6.ProviderServer
+	typeName string
+	schema   *tfprotov6.Schema
+	state    tftypes.Value
+	private  []byte
+}
+
+func newLifecycleTest(t *testing.T, fake *fakeOpenAI, typeName string) *lifecycleTest {
+	t.Helper()
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unable to create provider server: %s", err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unable to get provider schema: %s", err)
+	}
+	s, ok := schemaResp.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("unknown resource type %s", typeName)
+	}
+
+	config := dynamicValue(t, schemaResp.Provider.ValueType(), map[string]interface{}{
+		"api_key":   fakeAPIKey,
+		"admin_key": fakeAdminKey,
+		"base_url":  fake.URL,
+	})
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("unable to configure provider: %s", err)
+	}
+	requireNoDiagnostics(t, "configure provider", configureResp.Diagnostics)
+
+	return &lifecycleTest{
+		t:        t,
+		ctx:      ctx,
+		server:   server,
+		typeName: typeName,
+		schema:   s,
+		state:    tftypes.NewValue(s.ValueType(), nil),
+	}
+}
+
+// apply plans and applies the configuration, replacing the resource when the plan requires it.
+func (l *lifecycleTest) apply(config map[string]interface{}) {
+	l.t.Helper()
+	cfg := l.value(config)
+
+	validateResp, err := l.server.ValidateResourceConfig(l.ctx, &tfprotov6.ValidateResourceConfigRequest{TypeName: l.typeName, Config: l.dynamicValue(cfg)})
+	if err != nil {
+		l.t.Fatalf("unable to validate config: %s", err)
+	}
+	requireNoDiagnostics(l.t, "validate", validateResp.Diagnostics)
+
+	if !l.state.IsNull() {
+		l.refresh()
+	}
+	plan := l.plan(cfg)
+	if len(plan.RequiresReplace) > 0 && !l.state.IsNull() {
+		l.destroy()
+		plan = l.plan(cfg)
+	}
+	l.applyPlan(cfg, plan)
+}
+
+// assertNoChanges checks that refreshing and planning the configuration
+// against the current state proposes no changes.
+func (l *lifecycleTest) assertNoChanges(config map[string]interface{}) {
+	l.t.Helper()
+	l.refresh()
+	plan := l.plan(l.value(config))
+	planned, err := plan.PlannedState.Unmarshal(l.schema.ValueType())
+	if err != nil {
+		l.t.Fatalf("unable to decode planned state: %s", err)
+	}
+	if len(plan.RequiresReplace) > 0 {
+		l.t.Errorf("expected no changes, plan requires replacement for %v", plan.RequiresReplace)
+	}
+	l.assertEqualAttributes("plan", l.state, planned, nil)
+}
+
+// importState imports the resource by ID and checks that it reads back as the
+// current state, apart from the ignored attributes that cannot be imported.
+func (l *lifecycleTest) importState(id string, ignore ...string) {
+	l.t.Helper()
+	importResp, err := l.server.ImportResourceState(l.ctx, &tfprotov6.ImportResourceStateRequest{TypeName: l.typeName, ID: id})
+	if err != nil {
+		l.t.Fatalf("unable to import: %s", err)
+	}
+	requireNoDiagnostics(l.t, "import", importResp.Diagnostics)
+	if len(importResp.ImportedResources) != 1 {
+		l.t.Fatalf("expected 1 imported resource, got %d", len(importResp.ImportedResources))
+	}
+	imported := importResp.ImportedResources[0]
+
+	readResp, err := l.server.ReadResource(l.ctx, &tfprotov6.ReadResourceRequest{TypeName: l.typeName, CurrentState: imported.State, Private: imported.Private})
+	if err != nil {
+		l.t.Fatalf("unable to read imported resource: %s", err)
+	}
+	requireNoDiagnostics(l.t, "read imported resource", readResp.Diagnostics)
+	state, err := readResp.NewState.Unmarshal(l.schema.ValueType())
+	if err != nil {
+		l.t.Fatalf("unable to decode imported state: %s", err)
+	}
+
+	ignored := map[string]bool{}
+	for _, name := range ignore {
+		ignored[name] = true
+	}
+	l.assertEqualAttributes("import", l.state, state, ignored)
+}
+
+// destroy plans and applies the destruction of the resource.
+func (l *lifecycleTest) destroy() {
+	l.t.Helper()
+	null := tftypes.NewValue(l.schema.ValueType(), nil)
+	plan := l.plan(null)
+	l.applyPlan(null, plan)
+	if !l.state.IsNull() {
+		l.t.Fatalf("expected state to be removed on destroy, got %s", l.state)
+	}
+}
+
+// refresh reads the resource into the current state, which becomes null when the resource is gone.
+func (l *lifecycleTest) refresh() {
+	l.t.Helper()
+	readResp, err := l.server.ReadResource(l.ctx, &tfprotov6.ReadResourceRequest{TypeName: l.typeName, CurrentState: l.dynamicValue(l.state), Private: l.private})
+	if err != nil {
+		l.t.Fatalf("unable to read: %s", err)
+	}
+	requireNoDiagnostics(l.t, "read", readResp.Diagnostics)
+	l.state, err = readResp.NewState.Unmarshal(l.schema.ValueType())
+	if err != nil {
+		l.t.Fatalf("unable to decode state: %s", err)
+	}
+	l.private = readResp.Private
+}
+
+// attr returns a string attribute of the current state.
+func (l *lifecycleTest) attr(name string) string {
+	l.t.Helper()
+	var attrs map[string]tftypes.Value
+	if err := l.state.As(&attrs); err != nil {
+		l.t.Fatalf("unable to decode state: %s", err)
+	}
+	var s string
+	if err := attrs[name].As(&s); err != nil {
+		l.t.Fatalf("unable to decode %s: %s", name, err)
+	}
+	return s
+}
+
+func (l *lifecycleTest) plan(config tftypes.Value) *tfprotov6.PlanResourceChangeResponse {
+	l.t.Helper()
+	planResp, err := l.server.PlanResourceChange(l.ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         l.typeName,
+		PriorState:       l.dynamicValue(l.state),
+		ProposedNewState: l.dynamicValue(proposedNewState(l.schema.Block.Attributes, l.state, config)),
+		Config:           l.dynamicValue(config),
+		PriorPrivate:     l.private,
+	})
+	if err != nil {
+		l.t.Fatalf("unable to plan: %s", err)
+	}
+	requireNoDiagnostics(l.t, "plan", planResp.Diagnostics)
+	return planResp
+}
+
+func (l *lifecycleTest) applyPlan(config tftypes.Value, plan *tfprotov6.PlanResourceChangeResponse) {
+	l.t.Helper()
+	applyResp, err := l.server.ApplyResourceChange(l.ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       l.typeName,
+		PriorState:     l.dynamicValue(l.state),
+		PlannedState:   plan.PlannedState,
+		Config:         l.dynamicValue(config),
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		l.t.Fatalf("unable to apply: %s", err)
+	}
+	requireNoDiagnostics(l.t, "apply", applyResp.Diagnostics)
+
+	planned, err := plan.PlannedState.Unmarshal(l.schema.ValueType())
+	if err != nil {
+		l.t.Fatalf("unable to decode planned state: %s", err)
+	}
+	state, err := applyResp.NewState.Unmarshal(l.schema.ValueType())
+	if err != nil {
+		l.t.Fatalf("unable to decode new state: %s", err)
+	}
+	if !state.IsFullyKnown() {
+		l.t.Fatalf("apply left unknown values in state: %s", state)
+	}
+	// Like Terraform, reject new state that does not match the known planned values.
+	l.assertEqualAttributes("apply", planned, state, nil)
+
+	l.state = state
+	l.private = applyResp.Private
+}
+
+// applyWithErrors plans and applies the configuration of a resource that is
+// expected to fail, and returns the diagnostics. Like Terraform, the state
+// returned with the errors is kept, so that the resource can be destroyed.
+func (l *lifecycleTest) applyWithErrors(config map[string]interface{}) []*tfprotov6.Diagnostic {
+	l.t.Helper()
+	cfg := l.value(config)
+	plan := l.plan(cfg)
+	applyResp, err := l.server.ApplyResourceChange(l.ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       l.typeName,
+		PriorState:     l.dynamicValue(l.state),
+		PlannedState:   plan.PlannedState,
+		Config:         l.dynamicValue(cfg),
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		l.t.Fatalf("unable to apply: %s", err)
+	}
+
+	state, err := applyResp.NewState.Unmarshal(l.schema.ValueType())
+	if err != nil {
+		l.t.Fatalf("unable to decode new state: %s", err)
+	}
+	l.state = state
+	l.private = applyResp.Private
+	return applyResp.Diagnostics
+}
+
+// assertEqualAttributes compares the top-level attributes of two objects,
+// skipping the ignored ones and those unknown in want.
+func (l *lifecycleTest) assertEqualAttributes(step string, want tftypes.Value, got tftypes.Value, ignored map[string]bool) {
+	l.t.Helper()
+	if want.IsNull() || got.IsNull() {
+		if want.IsNull() != got.IsNull() {
+			l.t.Errorf("%s: expected %s, got %s", step, want, got)
+		}
+		return
+	}
+	var wantAttrs, gotAttrs map[string]tftypes.Value
+	if err := want.As(&wantAttrs); err != nil {
+		l.t.Fatalf("unable to decode value: %s", err)
+	}
+	if err := got.As(&gotAttrs); err != nil {
+		l.t.Fatalf("unable to decode value: %s", err)
+	}
+	for name, w := range wantAttrs {
+		if ignored[name] || !w.IsFullyKnown() {
+			continue
+		}
+		if !w.Equal(gotAttrs[name]) {
+			l.t.Errorf("%s: attribute %s changed from %s to %s", step, name, w, gotAttrs[name])
+		}
+	}
+}
+
+func (l *lifecycleTest) value(config map[string]interface{}) tftypes.Value {
+	l.t.Helper()
+	v, err := tfValue(l.schema.ValueType(), config)
+	if err != nil {
+		l.t.Fatalf("unable to build config: %s", err)
+	}
+	return v
+}
+
+func (l *lifecycleTest) dynamicValue(v tftypes.Value) *tfprotov6.DynamicValue {
+	l.t.Helper()
+	dv, err := tfprotov6.NewDynamicValue(l.schema.ValueType(), v)
+	if err != nil {
+		l.t.Fatalf("unable to encode value: %s", err)
+	}
+	return &dv
+}
+
+// proposedNewState merges the configuration with the prior state as Terraform
+// does before planning: computed attributes left out of the configuration keep
+// their prior value, single nested attributes are merged recursively.
+func proposedNewState(attributes []*tfprotov6.SchemaAttribute, prior tftypes.Value, config tftypes.Value) tftypes.Value {
+	if prior.IsNull() || config.IsNull() || !config.IsKnown() {
+		return config
+	}
+	var priorAttrs, configAttrs map[string]tftypes.Value
+	if prior.As(&priorAttrs) != nil || config.As(&configAttrs) != nil {
+		return config
+	}
+	proposed := map[string]tftypes.Value{}
+	for _, a := range attributes {
+		c := configAttrs[a.Name]
+		switch {
+		case a.Computed && c.IsNull():
+			proposed[a.Name] = priorAttrs[a.Name]
+		case a.NestedType != nil && a.NestedType.Nesting == tfprotov6.SchemaObjectNestingModeSingle:
+			proposed[a.Name] = proposedNewState(a.NestedType.Attributes, priorAttrs[a.Name], c)
+		default:
+			proposed[a.Name] = c
+		}
+	}
+	return tftypes.NewValue(config.Type(), proposed)
+}
+
+func requireNoDiagnostics(t *testing.T, step string, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s: %s", step, d.Summary, d.Detail)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// validateResourceConfig runs the provider's validation of a resource
+// configuration, as terraform validate and plan do, and returns the error
+// summaries. config holds Go values for the attributes that are set; all other
+// attributes are null.
+func validateResourceConfig(t *testing.T, newResource func() resource.Resource, config map[string]interface{}) []string {
+	ctx := context.Background()
+
+	var metadataResp resource.MetadataResponse
+	newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "openai"}, &metadataResp)
+	s := resourceSchema(ctx, newResource())
+
+	value, err := tfValue(s.Type().TerraformType(ctx), config)
+	if err != nil {
+		t.Fatalf("unable to build configuration: %s", err)
+	}
+	dv, err := tfprotov6.NewDynamicValue(value.Type(), value)
+	if err != nil {
+		t.Fatalf("unable to encode configuration: %s", err)
+	}
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unable to create provider server: %s", err)
+	}
+	resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: metadataResp.TypeName,
+		Config:   &dv,
+	})
+	if err != nil {
+		t.Fatalf("unable to validate configuration: %s", err)
+	}
+
+	var errors []string
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			errors = append(errors, d.Summary+": "+d.Detail)
+		}
+	}
+	return errors
+}
+
+// tfValue converts a Go value into a terraform value of the given type.
+// Objects are built from map[string]interface{} with missing attributes set
+// to null, lists from []interface{}, and maps from map[string]interface{}.
+func tfValue(typ tftypes.Type, v interface{}) (tftypes.Value, error) {
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected an object, got %T", v)
+		}
+		attrs := map[string]tftypes.Value{}
+		for name, attrType := range typ.AttributeTypes {
+			value, err := tfValue(attrType, m[name])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+			attrs[name] = value
+		}
+		for name := range m {
+			if _, ok := typ.AttributeTypes[name]; !ok {
+				return tftypes.Value{}, fmt.Errorf("unknown attribute %q", name)
+			}
+		}
+		return tftypes.NewValue(typ, attrs), nil
+	case tftypes.List:
+		l, ok := v.([]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a list, got %T", v)
+		}
+		elems := make([]tftypes.Value, len(l))
+		for i, e := range l {
+			value, err := tfValue(typ.ElementType, e)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elems[i] = value
+		}
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.Map:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a map, got %T", v)
+		}
+		elems := map[string]tftypes.Value{}
+		for k, e := range m {
+			value, err := tfValue(typ.ElementType, e)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("[%q]: %w", k, err)
+			}
+			elems[k] = value
+		}
+		return tftypes.NewValue(typ, elems), nil
+	}
+	switch {
+	case typ.Is(tftypes.Number):
+		switch n := v.(type) {
+		case int:
+			return tftypes.NewValue(typ, big.NewFloat(float64(n))), nil
+		case float64:
+			return tftypes.NewValue(typ, big.NewFloat(n)), nil
+		}
+	case typ.Is(tftypes.String), typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, v), nil
+	}
+	return tftypes.Value{}, fmt.Errorf("unsupported value %T for type %s", v, typ)
+}
+
+type schemaValidationCase struct {
+	name   string
+	config map[string]interface{}
+	// wantError is a substring of the expected error, or empty when the
+	// configuration is valid.
+	wantError string
+}
+
+func testSchemaValidation(t *testing.T, newResource func() resource.Resource, cases []schemaValidationCase) {
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errors := validateResourceConfig(t, newResource, c.config)
+			if c.wantError == "" {
+				assert.Empty(t, errors)
+				return
+			}
+			if assert.Len(t, errors, 1) {
+				assert.Contains(t, errors[0], c.wantError)
+			}
+		})
+	}
+}
+
+func stringMap(n int, keyLength int, valueLength int) map[string]interface{} {
+	m := map[string]interface{}{}
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("%d", i)
+		m[key+strings.Repeat("k", keyLength-len(key))] = strings.Repeat("v", valueLength)
+	}
+	return m
+}
+
+func repeatList(n int, v interface{}) []interface{} {
+	l := make([]interface{}, n)
+	for i := range l {
+		l[i] = v
+	}
+	return l
+}
+
+func TestAssistantResourceSchemaValidation(t *testing.T) {
+	tool := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":     "function",
+			"function": map[string]interface{}{"name": name, "parameters": `{"type": "object"}`},
+		}
+	}
+	testSchemaValidation(t, NewAssistantResource, []schemaValidationCase{
+		{"valid", map[string]interface{}{"model": "gpt-4o", "name": strings.Repeat("n", 256), "tools": []interface{}{tool("get_weather-v2")}, "metadata": stringMap(16, 64, 512)}, ""},
+		{"name too long", map[string]interface{}{"model": "gpt-4o", "name": strings.Repeat("n", 257)}, "256"},
+		{"description too long", map[string]interface{}{"model": "gpt-4o", "description": strings.Repeat("d", 513)}, "512"},
+		{"instructions too long", map[string]interface{}{"model": "gpt-4o", "instructions": strings.Repeat("i", 32769)}, "32768"},
+		{"too many metadata pairs", map[string]interface{}{"model": "gpt-4o", "metadata": stringMap(17, 2, 1)}, "16"},
+		{"metadata key too long", map[string]interface{}{"model": "gpt-4o", "metadata": stringMap(1, 65, 1)}, "64"},
+		{"metadata value too long", map[string]interface{}{"model": "gpt-4o", "metadata": stringMap(1, 1, 513)}, "512"},
+		{"too many tools", map[string]interface{}{"model": "gpt-4o", "tools": repeatList(129, map[string]interface{}{"type": "code_interpreter"})}, "128"},
+		{"invalid tool type", map[string]interface{}{"model": "gpt-4o", "tools": []interface{}{map[string]interface{}{"type": "retrieval"}}}, "code_interpreter"},
+		{"invalid function name", map[string]interface{}{"model": "gpt-4o", "tools": []interface{}{tool("get weather")}}, "underscores and dashes"},
+		{"function name too long", map[string]interface{}{"model": "gpt-4o", "tools": []interface{}{tool(strings.Repeat("f", 65))}}, "maximum length of 64"},
+		{"temperature out of range", map[string]interface{}{"model": "gpt-4o", "temperature": 2.5}, "between 0.000000 and 2.000000"},
+		{"top_p out of range", map[string]interface{}{"model": "gpt-4o", "top_p": 1.5}, "between 0.000000 and 1.000000"},
+		{"invalid response format type", map[string]interface{}{"model": "gpt-4o", "response_format": map[string]interface{}{"type": "xml"}}, "json_schema"},
+	})
+}
+
+func TestVectorStoreResourceSchemaValidation(t *testing.T) {
+	testSchemaValidation(t, NewVectorStoreResource, []schemaValidationCase{
+		{"valid", map[string]interface{}{"name": "test", "file_ids": []interface{}{}, "expires_after": map[string]interface{}{"anchor": "last_active_at", "days": 7}}, ""},
+		{"invalid anchor", map[string]interface{}{"name": "test", "file_ids": []interface{}{}, "expires_after": map[string]interface{}{"anchor": "created_at", "days": 7}}, "last_active_at"},
+		{"days out of range", map[string]interface{}{"name": "test", "file_ids": []interface{}{}, "expires_after": map[string]interface{}{"days": 366}}, "between 1 and 365"},
+		{"too many metadata pairs", map[string]interface{}{"name": "test", "file_ids": []interface{}{}, "metadata": stringMap(17, 2, 1)}, "16"},
+	})
+}
+
+func TestFileResourceSchemaValidation(t *testing.T) {
+	testSchemaValidation(t, NewFileResource, []schemaValidationCase{
+		{"valid", map[string]interface{}{"content": "{}", "purpose": "batch"}, ""},
+		{"invalid purpose", map[string]interface{}{"content": "{}", "purpose": "training"}, "fine-tune"},
+	})
+}
+
+func TestProjectResourceSchemaValidation(t *testing.T) {
+	testSchemaValidation(t, NewProjectResource, []schemaValidationCase{
+		{"valid", map[string]interface{}{"name": "test"}, ""},
+		{"empty name", map[string]interface{}{"name": ""}, "at least 1"},
+	})
+}
+
+func TestProjectServiceAccountResourceSchemaValidation(t *testing.T) {
+	testSchemaValidation(t, NewProjectServiceAccountResource, []schemaValidationCase{
+		{"valid", map[string]interface{}{"project_id": "proj_123", "name": "test"}, ""},
+		{"role is read-only", map[string]interface{}{"project_id": "proj_123", "name": "test", "role": "owner"}, "Read-Only Attribute"},
+	})
+}
+
+func TestFineTuningJobResourceSchemaValidation(t *testing.T) {
+	testSchemaValidation(t, NewFineTuningJobResource, []schemaValidationCase{
+		{"valid", map[string]interface{}{"model": "gpt-4o-mini-2024-07-18", "training_file": "file-123", "wait": true, "poll_interval": 1}, ""},
+		{"poll interval too short", map[string]interface{}{"model": "gpt-4o-mini-2024-07-18", "training_file": "file-123", "wait": true, "poll_interval": 0}, "at least 1"},
+	})
+}
+
+func TestBatchResourceSchemaValidation(t *testing.T) {
+	testSchemaValidation(t, NewBatchResource, []schemaValidationCase{
+		{"metadata key too long", map[string]interface{}{"input_file_id": "file-123", "endpoint": "/v1/chat/completions", "metadata": stringMap(1, 65, 1)}, "64"},
+	})
+}
+
+func TestAssistantRunResourceSchemaValidation(t *testing.T) {
+	testSchemaValidation(t, NewAssistantRunResource, []schemaValidationCase{
+		{"valid", map[string]interface{}{"assistant_id": "asst_123", "prompt": "hello", "expect_regex": "^hello", "expect_json_schema": `{"type": "object"}`}, ""},
+		{"invalid regex", map[string]interface{}{"assistant_id": "asst_123", "prompt": "hello", "expect_regex": "(unclosed"}, "Invalid Regular Expression"},
+		{"invalid json schema", map[string]interface{}{"assistant_id": "asst_123", "prompt": "hello", "expect_json_schema": `{"type": "bogus"}`}, "Invalid JSON Schema"},
+	})
+}
@@ -0,0 +1,319 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skyscrapr/openai-sdk-go/openai"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ThreadResource{}
+var _ resource.ResourceWithImportState = &ThreadResource{}
+
+func NewThreadResource() resource.Resource {
+	return &ThreadResource{OpenAIResource: &OpenAIResource{}}
+}
+
+// ThreadResource defines the resource implementation.
+type ThreadResource struct {
+	*OpenAIResource
+}
+
+func (r *ThreadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thread"
+}
+
+func (r *ThreadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Thread resource. Represents a thread that contains messages, seeded with an optional list of initial messages.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier, which can be referenced in API endpoints.",
+				Computed:            true,
+			},
+			"object": schema.StringAttribute{
+				MarkdownDescription: "The object type, which is always thread.",
+				Computed:            true,
+			},
+			"created_at": schema.Int64Attribute{
+				MarkdownDescription: "The Unix timestamp (in seconds) for when the thread was created.",
+				Computed:            true,
+			},
+			"messages": schema.ListNestedAttribute{
+				MarkdownDescription: "An ordered list of messages to start the thread with. Changing the messages creates a new thread.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the entity that is creating the message. Use user for messages sent by an actual user, and assistant for messages generated by the assistant.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("user", "assistant"),
+							},
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The text contents of the message.",
+							Required:            true,
+						},
+						"attachments": schema.ListNestedAttribute{
+							MarkdownDescription: "A list of files attached to the message, and the tools they should be added to.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"file_id": schema.StringAttribute{
+										MarkdownDescription: "The ID of the file to attach to the message.",
+										Required:            true,
+									},
+									"tools": schema.ListAttribute{
+										MarkdownDescription: "The tools to add this file to: code_interpreter or file_search.",
+										ElementType:         types.StringType,
+										Optional:            true,
+										Validators: []validator.List{
+											listvalidator.ValueStringsAre(stringvalidator.OneOf("code_interpreter", "file_search")),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tool_resources": schema.SingleNestedAttribute{
+				MarkdownDescription: "A set of resources that are made available to the assistant's tools in this thread. The resources are specific to the type of tool. For example, the code_interpreter tool requires a list of file IDs, while the file_search tool requires a list of vector store IDs.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"code_interpreter": schema.SingleNestedAttribute{
+						MarkdownDescription: "Resources for the code_interpreter tool.",
+						Optional:            true,
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"file_ids": schema.ListAttribute{
+								MarkdownDescription: "A list of file IDs made available to the code_interpreter tool. There can be a maximum of 20 files associated with the tool.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Computed:            true,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(20),
+								},
+							},
+						},
+					},
+					"file_search": schema.SingleNestedAttribute{
+						MarkdownDescription: "Resources for the file_search tool.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"vector_store_ids": schema.ListAttribute{
+								MarkdownDescription: "The vector store attached to this thread. There can be a maximum of 1 vector store attached to the thread.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Computed:            true,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+						},
+					},
+				},
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Set of 16 key-value pairs that can be attached to an object. This can be useful for storing additional information about the object in a structured format. Keys can be a maximum of 64 characters long and values can be a maximum of 512 characters long.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          metadataValidators(),
+			},
+		},
+	}
+}
+
+func (r *ThreadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OpenAIThreadResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tReq := CreateThreadRequest{
+		ToolResources: expandAssistantToolResources(ctx, data.ToolResources),
+	}
+	tReq.Messages, diags = expandThreadMessages(ctx, data.Messages)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &tReq.MetaData, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating Thread...")
+	thread, err := r.client.WithContext(ctx).Threads().CreateThread(&tReq)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create thread", err))
+		return
+	}
+	tflog.Info(ctx, "Thread created successfully")
+
+	data, diags = NewOpenAIThreadResourceModel(ctx, thread, data.Messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ThreadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OpenAIThreadResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading Thread with id: %s", data.Id.ValueString()))
+	thread, err := r.client.WithContext(ctx).Threads().RetrieveThread(data.Id.ValueString())
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Thread does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve thread", err))
+		return
+	}
+
+	data, diags = NewOpenAIThreadResourceModel(ctx, thread, data.Messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ThreadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OpenAIThreadResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	var state OpenAIThreadResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send empty values so that removing an attribute also clears it in the API.
+	tReq := ModifyThreadRequest{
+		ToolResources: expandAssistantToolResources(ctx, data.ToolResources),
+	}
+	if tReq.ToolResources == nil {
+		tReq.ToolResources = &openai.AssistantToolResources{}
+	}
+	resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &tReq.MetaData, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if tReq.MetaData == nil {
+		tReq.MetaData = map[string]string{}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating Thread: %s", state.Id.ValueString()))
+	thread, err := r.client.WithContext(ctx).Threads().ModifyThread(state.Id.ValueString(), &tReq)
+	if err != nil {
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to modify thread", err))
+		return
+	}
+	tflog.Info(ctx, "Thread modified successfully")
+
+	data, diags = NewOpenAIThreadResourceModel(ctx, thread, data.Messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ThreadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OpenAIThreadResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting Thread: %s", data.Id.ValueString()))
+	deleted, err := r.client.WithContext(ctx).Threads().DeleteThread(data.Id.ValueString())
+	if err != nil {
+		apiError := GetOpenAIAPIError(err)
+		if apiError != nil && apiError.HTTPStatusCode == 404 {
+			tflog.Info(ctx, "Thread does not exist")
+			return
+		}
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to delete thread", err))
+		return
+	}
+	if !deleted {
+		tflog.Trace(ctx, "Thread not deleted")
+	}
+	tflog.Trace(ctx, "Thread deleted successfully")
+}
+
+func (r *ThreadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandThreadMessages(ctx context.Context, messages []OpenAIThreadMessageModel) ([]ThreadMessageRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var requests []ThreadMessageRequest
+	for _, m := range messages {
+		message := ThreadMessageRequest{
+			Role:    m.Role.ValueString(),
+			Content: m.Content.ValueString(),
+		}
+		for _, a := range m.Attachments {
+			attachment := ThreadMessageAttachment{
+				FileId: a.FileId.ValueString(),
+			}
+			var tools []string
+			diags.Append(a.Tools.ElementsAs(ctx, &tools, false)...)
+			for _, t := range tools {
+				attachment.Tools = append(attachment.Tools, ThreadMessageAttachmentTool{Type: t})
+			}
+			message.Attachments = append(message.Attachments, attachment)
+		}
+		requests = append(requests, message)
+	}
+	return requests, diags
+}
@@ -0,0 +1,147 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccThreadResource(t *testing.T) {
+	rName := testAccRandomName("openai_tf_test_")
+	threadResourceName := "openai_thread.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccThreadResourceConfig(rName, "demo"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(threadResourceName, "id"),
+					resource.TestCheckResourceAttr(threadResourceName, "object", "thread"),
+					resource.TestCheckResourceAttr(threadResourceName, "messages.#", "2"),
+					resource.TestCheckResourceAttr(threadResourceName, "messages.0.attachments.0.tools.0", "code_interpreter"),
+					resource.TestCheckResourceAttrPair(threadResourceName, "tool_resources.file_search.vector_store_ids.0", "openai_vector_store.test", "id"),
+					resource.TestCheckResourceAttr(threadResourceName, "metadata.environment", "demo"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            threadResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"messages"},
+			},
+			// Update in place testing
+			{
+				Config: testAccThreadResourceConfig(rName, "evaluation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(threadResourceName, "metadata.environment", "evaluation"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccThreadResourceConfig(rName string, environment string) string {
+	return fmt.Sprintf(`
+resource openai_file test {
+	filepath = "./test-fixtures/test.jsonl"
+	purpose = "assistants"
+}
+
+resource openai_vector_store test {
+	name = %[1]q
+	file_ids = [openai_file.test.id]
+}
+
+resource openai_thread test {
+	messages = [
+		{
+			role = "user"
+			content = "Summarise the attached data."
+			attachments = [
+				{
+					file_id = openai_file.test.id
+					tools = ["code_interpreter"]
+				}
+			]
+		},
+		{
+			role = "assistant"
+			content = "Sure, which columns are you interested in?"
+		}
+	]
+	tool_resources = {
+		file_search = {
+			vector_store_ids = [openai_vector_store.test.id]
+		}
+	}
+	metadata = {
+		environment = %[2]q
+	}
+}
+`, rName, environment)
+}
+
+func TestThreadResource_RequestBody(t *testing.T) {
+	ctx := context.Background()
+	body := `{
+		"id": "thread_123",
+		"object": "thread",
+		"created_at": 1700000000,
+		"tool_resources": {"code_interpreter": {"file_ids": ["file-123"]}},
+		"metadata": {"environment": "demo"}
+	}`
+	client, recorder := newRecordingTestClient(t, body)
+	r := &ThreadResource{OpenAIResource: &OpenAIResource{client: client}}
+	s := resourceSchema(ctx, r)
+
+	var thread *Thread
+	assert.NoError(t, json.Unmarshal([]byte(body), &thread))
+	model, diags := NewOpenAIThreadResourceModel(ctx, thread, []OpenAIThreadMessageModel{
+		{
+			Role:    types.StringValue("user"),
+			Content: types.StringValue("hello"),
+			Attachments: []OpenAIThreadMessageAttachmentModel{
+				{FileId: types.StringValue("file-123"), Tools: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file_search")})},
+			},
+		},
+	})
+	assert.False(t, diags.HasError())
+
+	createResp := fwresource.CreateResponse{State: newTestState(t, ctx, s, nil)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: newTestPlan(t, ctx, s, &model)}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	req := recorder.last(t)
+	assert.Equal(t, "/v1/threads", req.Path)
+	assertRequestCoversSchema(t, s, req.Body)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"role":        "user",
+			"content":     "hello",
+			"attachments": []interface{}{map[string]interface{}{"file_id": "file-123", "tools": []interface{}{map[string]interface{}{"type": "file_search"}}}},
+		},
+	}, req.Body["messages"])
+
+	// The initial messages are not sent on update, changing them replaces the thread.
+	model.Metadata = types.MapNull(types.StringType)
+	model.ToolResources = nil
+	updateResp := fwresource.UpdateResponse{State: newTestState(t, ctx, s, &model)}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: newTestPlan(t, ctx, s, &model), State: newTestState(t, ctx, s, &model)}, &updateResp)
+	assert.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+	req = recorder.last(t)
+	assert.Equal(t, "/v1/threads/thread_123", req.Path)
+	assertRequestCoversSchema(t, s, req.Body, "messages")
+	assert.Equal(t, map[string]interface{}{}, req.Body["metadata"])
+	assert.Equal(t, map[string]interface{}{}, req.Body["tool_resources"])
+}
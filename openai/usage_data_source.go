@@ -0,0 +1,470 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsageDataSource{}
+
+// usageMetric is a counter reported by a type of usage.
+type usageMetric struct {
+	name        string
+	description string
+	value       func(r *UsageResult) *int64
+}
+
+// usageGroup is a field the results of a type of usage can be grouped by.
+type usageGroup struct {
+	name        string
+	description string
+	attrType    attr.Type
+	value       func(r *UsageResult) attr.Value
+}
+
+// usageType describes one of the usage endpoints. The data sources only differ
+// in the metrics they report and the fields they can be grouped and filtered by.
+type usageType struct {
+	name        string
+	description string
+	metrics     []usageMetric
+	groups      []usageGroup
+	// filters are the optional list filters supported besides project_ids.
+	filters []string
+	// batchFilter is set when the usage can be filtered to batch or non-batch jobs.
+	batchFilter bool
+}
+
+var (
+	numModelRequestsMetric = usageMetric{"num_model_requests", "The count of requests made to the model.", func(r *UsageResult) *int64 { return r.NumModelRequests }}
+	inputTokensMetric      = usageMetric{"input_tokens", "The aggregated number of input tokens used.", func(r *UsageResult) *int64 { return r.InputTokens }}
+
+	projectIdGroup = usageGroup{"project_id", "The project ID, when grouped by project_id.", types.StringType, func(r *UsageResult) attr.Value { return types.StringPointerValue(r.ProjectId) }}
+	userIdGroup    = usageGroup{"user_id", "The user ID, when grouped by user_id.", types.StringType, func(r *UsageResult) attr.Value { return types.StringPointerValue(r.UserId) }}
+	apiKeyIdGroup  = usageGroup{"api_key_id", "The API key ID, when grouped by api_key_id.", types.StringType, func(r *UsageResult) attr.Value { return types.StringPointerValue(r.ApiKeyId) }}
+	modelGroup     = usageGroup{"model", "The model name, when grouped by model.", types.StringType, func(r *UsageResult) attr.Value { return types.StringPointerValue(r.Model) }}
+	batchGroup     = usageGroup{"batch", "Whether the usage came from batch jobs, when grouped by batch.", types.BoolType, func(r *UsageResult) attr.Value { return types.BoolPointerValue(r.Batch) }}
+	sizeGroup      = usageGroup{"size", "The image size, when grouped by size.", types.StringType, func(r *UsageResult) attr.Value { return types.StringPointerValue(r.Size) }}
+	sourceGroup    = usageGroup{"source", "The source of the image generation, when grouped by source.", types.StringType, func(r *UsageResult) attr.Value { return types.StringPointerValue(r.Source) }}
+
+	modelUsageGroups  = []usageGroup{projectIdGroup, userIdGroup, apiKeyIdGroup, modelGroup}
+	modelUsageFilters = []string{"user_ids", "api_key_ids", "models"}
+)
+
+var usageTypes = map[string]usageType{
+	"completions": {
+		name:        "completions",
+		description: "Completions usage data source. Returns the completions usage of the organization in time buckets. Requires an admin key.",
+		metrics: []usageMetric{
+			inputTokensMetric,
+			{"output_tokens", "The aggregated number of output tokens used.", func(r *UsageResult) *int64 { return r.OutputTokens }},
+			{"input_cached_tokens", "The aggregated number of input tokens that have been cached from previous requests.", func(r *UsageResult) *int64 { return r.InputCachedTokens }},
+			{"input_audio_tokens", "The aggregated number of audio input tokens used, including cached tokens.", func(r *UsageResult) *int64 { return r.InputAudioTokens }},
+			{"output_audio_tokens", "The aggregated number of audio output tokens used.", func(r *UsageResult) *int64 { return r.OutputAudioTokens }},
+			numModelRequestsMetric,
+		},
+		groups:      []usageGroup{projectIdGroup, userIdGroup, apiKeyIdGroup, modelGroup, batchGroup},
+		filters:     modelUsageFilters,
+		batchFilter: true,
+	},
+	"embeddings": {
+		name:        "embeddings",
+		description: "Embeddings usage data source. Returns the embeddings usage of the organization in time buckets. Requires an admin key.",
+		metrics:     []usageMetric{inputTokensMetric, numModelRequestsMetric},
+		groups:      modelUsageGroups,
+		filters:     modelUsageFilters,
+	},
+	"moderations": {
+		name:        "moderations",
+		description: "Moderations usage data source. Returns the moderations usage of the organization in time buckets. Requires an admin key.",
+		metrics:     []usageMetric{inputTokensMetric, numModelRequestsMetric},
+		groups:      modelUsageGroups,
+		filters:     modelUsageFilters,
+	},
+	"images": {
+		name:        "images",
+		description: "Images usage data source. Returns the images usage of the organization in time buckets. Requires an admin key.",
+		metrics: []usageMetric{
+			{"images", "The number of images processed.", func(r *UsageResult) *int64 { return r.Images }},
+			numModelRequestsMetric,
+		},
+		groups:  []usageGroup{projectIdGroup, userIdGroup, apiKeyIdGroup, modelGroup, sizeGroup, sourceGroup},
+		filters: modelUsageFilters,
+	},
+	"audio_speeches": {
+		name:        "audio_speeches",
+		description: "Audio speeches usage data source. Returns the text to speech usage of the organization in time buckets. Requires an admin key.",
+		metrics: []usageMetric{
+			{"characters", "The number of characters processed.", func(r *UsageResult) *int64 { return r.Characters }},
+			numModelRequestsMetric,
+		},
+		groups:  modelUsageGroups,
+		filters: modelUsageFilters,
+	},
+	"audio_transcriptions": {
+		name:        "audio_transcriptions",
+		description: "Audio transcriptions usage data source. Returns the speech to text usage of the organization in time buckets. Requires an admin key.",
+		metrics: []usageMetric{
+			{"seconds", "The number of seconds processed.", func(r *UsageResult) *int64 { return r.Seconds }},
+			numModelRequestsMetric,
+		},
+		groups:  modelUsageGroups,
+		filters: modelUsageFilters,
+	},
+	"vector_stores": {
+		name:        "vector_stores",
+		description: "Vector stores usage data source. Returns the vector store storage of the organization in time buckets. Requires an admin key.",
+		metrics: []usageMetric{
+			{"usage_bytes", "The vector stores usage in bytes.", func(r *UsageResult) *int64 { return r.UsageBytes }},
+		},
+		groups: []usageGroup{projectIdGroup},
+	},
+	"code_interpreter_sessions": {
+		name:        "code_interpreter_sessions",
+		description: "Code interpreter sessions usage data source. Returns the code interpreter sessions of the organization in time buckets. Requires an admin key.",
+		metrics: []usageMetric{
+			{"num_sessions", "The number of code interpreter sessions.", func(r *UsageResult) *int64 { return r.NumSessions }},
+		},
+		groups: []usageGroup{projectIdGroup},
+	},
+}
+
+func NewUsageCompletionsDataSource() datasource.DataSource {
+	return &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageTypes["completions"]}
+}
+
+func NewUsageEmbeddingsDataSource() datasource.DataSource {
+	return &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageTypes["embeddings"]}
+}
+
+func NewUsageModerationsDataSource() datasource.DataSource {
+	return &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageTypes["moderations"]}
+}
+
+func NewUsageImagesDataSource() datasource.DataSource {
+	return &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageTypes["images"]}
+}
+
+func NewUsageAudioSpeechesDataSource() datasource.DataSource {
+	return &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageTypes["audio_speeches"]}
+}
+
+func NewUsageAudioTranscriptionsDataSource() datasource.DataSource {
+	return &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageTypes["audio_transcriptions"]}
+}
+
+func NewUsageVectorStoresDataSource() datasource.DataSource {
+	return &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageTypes["vector_stores"]}
+}
+
+func NewUsageCodeInterpreterSessionsDataSource() datasource.DataSource {
+	return &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageTypes["code_interpreter_sessions"]}
+}
+
+// UsageDataSource defines the data source implementation shared by all types of usage.
+type UsageDataSource struct {
+	*OpenAIDatasource
+	usageType usageType
+}
+
+func (d *UsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_" + d.usageType.name
+}
+
+func (d *UsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var groupNames []string
+	resultAttributes := map[string]schema.Attribute{}
+	for _, g := range d.usageType.groups {
+		groupNames = append(groupNames, g.name)
+		if g.attrType == types.BoolType {
+			resultAttributes[g.name] = schema.BoolAttribute{MarkdownDescription: g.description, Computed: true}
+		} else {
+			resultAttributes[g.name] = schema.StringAttribute{MarkdownDescription: g.description, Computed: true}
+		}
+	}
+	totalAttributes := map[string]schema.Attribute{}
+	for _, m := range d.usageType.metrics {
+		resultAttributes[m.name] = schema.Int64Attribute{MarkdownDescription: m.description, Computed: true}
+		totalAttributes[m.name] = schema.Int64Attribute{MarkdownDescription: m.description, Computed: true}
+	}
+
+	attributes := usageWindowAttributes()
+	attributes["group_by"] = schema.ListAttribute{
+		MarkdownDescription: fmt.Sprintf("Group the usage data by the specified fields: %s.", joinQuoted(groupNames)),
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(stringvalidator.OneOf(groupNames...)),
+		},
+	}
+	attributes["bucket_width"] = schema.StringAttribute{
+		MarkdownDescription: "Width of each time bucket in response: 1m, 1h or 1d. Defaults to 1d.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("1m", "1h", "1d"),
+		},
+	}
+	for _, f := range d.usageType.filters {
+		attributes[f] = schema.ListAttribute{
+			MarkdownDescription: usageFilterDescriptions[f],
+			ElementType:         types.StringType,
+			Optional:            true,
+		}
+	}
+	if d.usageType.batchFilter {
+		attributes["batch"] = schema.BoolAttribute{
+			MarkdownDescription: "If true, return batch jobs only. If false, return non-batch jobs only. By default, return both.",
+			Optional:            true,
+		}
+	}
+	attributes["buckets"] = usageBucketsAttribute(resultAttributes)
+	attributes["totals"] = schema.SingleNestedAttribute{
+		MarkdownDescription: "The metrics summed over all buckets and results.",
+		Computed:            true,
+		Attributes:          totalAttributes,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: d.usageType.description,
+		Attributes:          attributes,
+	}
+}
+
+var usageFilterDescriptions = map[string]string{
+	"user_ids":    "Return only usage for these users.",
+	"api_key_ids": "Return only usage for these API keys.",
+	"models":      "Return only usage for these models.",
+}
+
+func (d *UsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	uReq, diags := readUsageRequest(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	for _, f := range d.usageType.filters {
+		var values []string
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(f), &values)...)
+		switch f {
+		case "user_ids":
+			uReq.UserIds = values
+		case "api_key_ids":
+			uReq.ApiKeyIds = values
+		case "models":
+			uReq.Models = values
+		}
+	}
+	if d.usageType.batchFilter {
+		var batch types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("batch"), &batch)...)
+		uReq.Batch = batch.ValueBoolPointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resultAttrTypes := d.resultAttrTypes()
+	totalAttrTypes := map[string]attr.Type{}
+	totals := map[string]int64{}
+	for _, m := range d.usageType.metrics {
+		totalAttrTypes[m.name] = types.Int64Type
+		totals[m.name] = 0
+	}
+
+	var buckets []attr.Value
+	for {
+		tflog.Debug(ctx, fmt.Sprintf("Reading %s usage page", d.usageType.name))
+		page, err := d.client.WithContext(ctx).Usage().GetUsage(d.usageType.name, uReq)
+		if err != nil {
+			resp.Diagnostics.Append(NewOpenAIErrorDiagnostic(fmt.Sprintf("Unable to read %s usage", d.usageType.name), err))
+			return
+		}
+		for _, b := range page.Data {
+			var results []attr.Value
+			for i := range b.Results {
+				r := &b.Results[i]
+				attrs := map[string]attr.Value{}
+				for _, g := range d.usageType.groups {
+					attrs[g.name] = g.value(r)
+				}
+				for _, m := range d.usageType.metrics {
+					v := m.value(r)
+					attrs[m.name] = types.Int64PointerValue(v)
+					if v != nil {
+						totals[m.name] += *v
+					}
+				}
+				result, diags := types.ObjectValue(resultAttrTypes, attrs)
+				resp.Diagnostics.Append(diags...)
+				results = append(results, result)
+			}
+			bucket, diags := newUsageBucketValue(b.StartTime, b.EndTime, resultAttrTypes, results)
+			resp.Diagnostics.Append(diags...)
+			buckets = append(buckets, bucket)
+		}
+		if !page.HasMore || page.NextPage == nil {
+			break
+		}
+		uReq.Page = page.NextPage
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	totalValues := map[string]attr.Value{}
+	for name, v := range totals {
+		totalValues[name] = types.Int64Value(v)
+	}
+	totalsValue, diags := types.ObjectValue(totalAttrTypes, totalValues)
+	resp.Diagnostics.Append(diags...)
+	bucketsValue, diags := types.ListValue(types.ObjectType{AttrTypes: usageBucketAttrTypes(resultAttrTypes)}, buckets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state, starting from the configured query attributes
+	resp.State.Raw = req.Config.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.FormatInt(time.Now().Unix(), 10)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("buckets"), bucketsValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("totals"), totalsValue)...)
+}
+
+func (d *UsageDataSource) resultAttrTypes() map[string]attr.Type {
+	attrTypes := map[string]attr.Type{}
+	for _, g := range d.usageType.groups {
+		attrTypes[g.name] = g.attrType
+	}
+	for _, m := range d.usageType.metrics {
+		attrTypes[m.name] = types.Int64Type
+	}
+	return attrTypes
+}
+
+// usageWindowAttributes are the query attributes shared by the usage and costs data sources.
+func usageWindowAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Usage identifier",
+			Computed:            true,
+		},
+		"start_time": schema.StringAttribute{
+			MarkdownDescription: "Start time (RFC3339) of the query time range, inclusive.",
+			CustomType:          timetypes.RFC3339Type{},
+			Required:            true,
+		},
+		"end_time": schema.StringAttribute{
+			MarkdownDescription: "End time (RFC3339) of the query time range, exclusive. Defaults to now.",
+			CustomType:          timetypes.RFC3339Type{},
+			Optional:            true,
+		},
+		"project_ids": schema.ListAttribute{
+			MarkdownDescription: "Return only usage for these projects.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
+
+func usageBucketsAttribute(resultAttributes map[string]schema.Attribute) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "The time buckets in the query time range, across all pages.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start_time": schema.StringAttribute{
+					MarkdownDescription: "Start time (RFC3339) of the bucket.",
+					CustomType:          timetypes.RFC3339Type{},
+					Computed:            true,
+				},
+				"end_time": schema.StringAttribute{
+					MarkdownDescription: "End time (RFC3339) of the bucket.",
+					CustomType:          timetypes.RFC3339Type{},
+					Computed:            true,
+				},
+				"results": schema.ListNestedAttribute{
+					MarkdownDescription: "The results in the bucket, one per group.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: resultAttributes,
+					},
+				},
+			},
+		},
+	}
+}
+
+func usageBucketAttrTypes(resultAttrTypes map[string]attr.Type) map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_time": timetypes.RFC3339Type{},
+		"end_time":   timetypes.RFC3339Type{},
+		"results":    types.ListType{ElemType: types.ObjectType{AttrTypes: resultAttrTypes}},
+	}
+}
+
+func newUsageBucketValue(startTime int64, endTime int64, resultAttrTypes map[string]attr.Type, results []attr.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resultsValue, d := types.ListValue(types.ObjectType{AttrTypes: resultAttrTypes}, results)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	bucket, d := types.ObjectValue(usageBucketAttrTypes(resultAttrTypes), map[string]attr.Value{
+		"start_time": timetypes.NewRFC3339TimeValue(time.Unix(startTime, 0).UTC()),
+		"end_time":   timetypes.NewRFC3339TimeValue(time.Unix(endTime, 0).UTC()),
+		"results":    resultsValue,
+	})
+	diags.Append(d...)
+	return bucket, diags
+}
+
+// readUsageRequest reads the query attributes shared by the usage and costs data sources.
+func readUsageRequest(ctx context.Context, config tfsdk.Config) (*UsageRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var startTime, endTime timetypes.RFC3339
+	var bucketWidth types.String
+	var projectIds, groupBy []string
+	diags.Append(config.GetAttribute(ctx, path.Root("start_time"), &startTime)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("end_time"), &endTime)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("bucket_width"), &bucketWidth)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("project_ids"), &projectIds)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("group_by"), &groupBy)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	uReq := &UsageRequest{
+		BucketWidth: bucketWidth.ValueStringPointer(),
+		ProjectIds:  projectIds,
+		GroupBy:     groupBy,
+	}
+	t, d := startTime.ValueRFC3339Time()
+	diags.Append(d...)
+	uReq.StartTime = t.Unix()
+	if !endTime.IsNull() {
+		t, d := endTime.ValueRFC3339Time()
+		diags.Append(d...)
+		end := t.Unix()
+		uReq.EndTime = &end
+	}
+	return uReq, diags
+}
+
+func joinQuoted(values []string) string {
+	return "`" + strings.Join(values, "`, `") + "`"
+}
@@ -0,0 +1,217 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccUsageCompletionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUsageCompletionsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.openai_usage_completions.test", "id"),
+					resource.TestCheckResourceAttrSet("data.openai_usage_completions.test", "totals.input_tokens"),
+					resource.TestCheckResourceAttrSet("data.openai_costs.test", "total_amount"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUsageCompletionsDataSourceConfig = `
+data "openai_usage_completions" "test" {
+	start_time   = "2025-01-01T00:00:00Z"
+	end_time     = "2025-01-08T00:00:00Z"
+	bucket_width = "1d"
+	group_by     = ["project_id", "model"]
+}
+
+data "openai_costs" "test" {
+	start_time = "2025-01-01T00:00:00Z"
+	end_time   = "2025-01-08T00:00:00Z"
+	group_by   = ["project_id"]
+}
+`
+
+// newPagedUsageTestClient returns a client whose server serves pages in order
+// and records the query of each request.
+func newPagedUsageTestClient(t *testing.T, pages ...string) (*OpenAIClient, *[]url.Values) {
+	var queries []url.Values
+	client := newTestOpenAIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-admin-key", r.Header.Get("Authorization"))
+		queries = append(queries, r.URL.Query())
+		if len(queries) > len(pages) {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(pages[len(queries)-1]))
+	})
+	return client, &queries
+}
+
+// newTestUsageConfig returns a data source configuration with the attributes in
+// config set and all other attributes null.
+func newTestUsageConfig(t *testing.T, ctx context.Context, d datasource.DataSource, config map[string]interface{}) tfsdk.Config {
+	s := dataSourceSchema(ctx, d)
+	value, err := tfValue(s.Type().TerraformType(ctx), config)
+	if err != nil {
+		t.Fatalf("unable to build configuration: %s", err)
+	}
+	return tfsdk.Config{Schema: s, Raw: value}
+}
+
+func TestUsageDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	client, queries := newPagedUsageTestClient(t,
+		`{
+			"object": "page",
+			"data": [{
+				"object": "bucket", "start_time": 1735689600, "end_time": 1735776000,
+				"results": [
+					{"object": "organization.usage.completions.result", "input_tokens": 100, "output_tokens": 10, "num_model_requests": 2, "project_id": "proj_a", "model": "gpt-4o"},
+					{"object": "organization.usage.completions.result", "input_tokens": 50, "output_tokens": 5, "num_model_requests": 1, "project_id": "proj_b", "model": "gpt-4o"}
+				]
+			}],
+			"has_more": true,
+			"next_page": "page_2"
+		}`,
+		`{
+			"object": "page",
+			"data": [{
+				"object": "bucket", "start_time": 1735776000, "end_time": 1735862400,
+				"results": [
+					{"object": "organization.usage.completions.result", "input_tokens": 25, "output_tokens": 1, "num_model_requests": 1, "project_id": "proj_a", "model": "gpt-4o-mini"}
+				]
+			}],
+			"has_more": false,
+			"next_page": null
+		}`,
+	)
+	d := &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}, usageType: usageTypes["completions"]}
+	s := dataSourceSchema(ctx, d)
+
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestUsageConfig(t, ctx, d, map[string]interface{}{
+		"start_time":   "2025-01-01T00:00:00Z",
+		"end_time":     "2025-01-03T00:00:00Z",
+		"bucket_width": "1d",
+		"group_by":     []interface{}{"project_id", "model"},
+		"project_ids":  []interface{}{"proj_a", "proj_b"},
+		"models":       []interface{}{"gpt-4o", "gpt-4o-mini"},
+		"batch":        false,
+	})}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	if assert.Len(t, *queries, 2) {
+		q := (*queries)[0]
+		assert.Equal(t, "1735689600", q.Get("start_time"))
+		assert.Equal(t, "1735862400", q.Get("end_time"))
+		assert.Equal(t, "1d", q.Get("bucket_width"))
+		assert.Equal(t, []string{"project_id", "model"}, q["group_by"])
+		assert.Equal(t, []string{"proj_a", "proj_b"}, q["project_ids"])
+		assert.Equal(t, []string{"gpt-4o", "gpt-4o-mini"}, q["models"])
+		assert.Equal(t, "false", q.Get("batch"))
+		assert.Empty(t, q.Get("page"))
+		assert.Equal(t, "page_2", (*queries)[1].Get("page"))
+	}
+
+	var totals types.Object
+	assert.False(t, resp.State.GetAttribute(ctx, path.Root("totals"), &totals).HasError())
+	assert.Equal(t, types.Int64Value(175), totals.Attributes()["input_tokens"])
+	assert.Equal(t, types.Int64Value(16), totals.Attributes()["output_tokens"])
+	assert.Equal(t, types.Int64Value(4), totals.Attributes()["num_model_requests"])
+	assert.Equal(t, types.Int64Value(0), totals.Attributes()["input_audio_tokens"])
+
+	var buckets []types.Object
+	assert.False(t, resp.State.GetAttribute(ctx, path.Root("buckets"), &buckets).HasError())
+	if assert.Len(t, buckets, 2) {
+		assert.Equal(t, `"2025-01-02T00:00:00Z"`, buckets[1].Attributes()["start_time"].String())
+		results := buckets[0].Attributes()["results"].(types.List).Elements()
+		if assert.Len(t, results, 2) {
+			result := results[1].(types.Object).Attributes()
+			assert.Equal(t, types.StringValue("proj_b"), result["project_id"])
+			assert.Equal(t, types.Int64Value(50), result["input_tokens"])
+			assert.Equal(t, types.Int64Null(), result["input_cached_tokens"])
+			assert.Equal(t, types.BoolNull(), result["batch"])
+		}
+	}
+
+	var models []string
+	assert.False(t, resp.State.GetAttribute(ctx, path.Root("models"), &models).HasError())
+	assert.Equal(t, []string{"gpt-4o", "gpt-4o-mini"}, models)
+}
+
+func TestUsageDataSource_Schema(t *testing.T) {
+	ctx := context.Background()
+	for name, usageType := range usageTypes {
+		t.Run(name, func(t *testing.T) {
+			d := &UsageDataSource{OpenAIDatasource: &OpenAIDatasource{}, usageType: usageType}
+			s := dataSourceSchema(ctx, d)
+			assert.Empty(t, s.ValidateImplementation(ctx))
+			_, hasBatch := s.Attributes["batch"]
+			assert.Equal(t, usageType.batchFilter, hasBatch)
+			results := d.resultAttrTypes()
+			assert.Len(t, results, len(usageType.groups)+len(usageType.metrics))
+		})
+	}
+}
+
+func TestCostsDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	client, queries := newPagedUsageTestClient(t,
+		`{
+			"object": "page",
+			"data": [
+				{"object": "bucket", "start_time": 1735689600, "end_time": 1735776000, "results": [
+					{"object": "organization.costs.result", "amount": {"value": 1.25, "currency": "usd"}, "line_item": null, "project_id": "proj_a"},
+					{"object": "organization.costs.result", "amount": {"value": 0.5, "currency": "usd"}, "line_item": null, "project_id": "proj_b"}
+				]},
+				{"object": "bucket", "start_time": 1735776000, "end_time": 1735862400, "results": []}
+			],
+			"has_more": false,
+			"next_page": null
+		}`,
+	)
+	d := &CostsDataSource{OpenAIDatasource: &OpenAIDatasource{client: client}}
+	s := dataSourceSchema(ctx, d)
+
+	resp := datasource.ReadResponse{State: newTestDataSourceState(ctx, s)}
+	d.Read(ctx, datasource.ReadRequest{Config: newTestUsageConfig(t, ctx, d, map[string]interface{}{
+		"start_time": "2025-01-01T00:00:00Z",
+		"group_by":   []interface{}{"project_id"},
+	})}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	if assert.Len(t, *queries, 1) {
+		q := (*queries)[0]
+		assert.Equal(t, "1735689600", q.Get("start_time"))
+		assert.Empty(t, q.Get("end_time"))
+		assert.Equal(t, []string{"project_id"}, q["group_by"])
+	}
+
+	var state CostsDataSourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, types.Float64Value(1.75), state.TotalAmount)
+	assert.Equal(t, types.StringValue("usd"), state.Currency)
+	if assert.Len(t, state.Buckets, 2) {
+		assert.Len(t, state.Buckets[0].Results, 2)
+		assert.Equal(t, types.StringValue("proj_b"), state.Buckets[0].Results[1].ProjectId)
+		assert.Equal(t, types.StringNull(), state.Buckets[0].Results[1].LineItem)
+		assert.Empty(t, state.Buckets[1].Results)
+	}
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("project_id")}), state.GroupBy)
+}
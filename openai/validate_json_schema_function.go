@@ -0,0 +1,50 @@
+package openai
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ValidateJSONSchemaFunction{}
+
+func NewValidateJSONSchemaFunction() function.Function {
+	return &ValidateJSONSchemaFunction{}
+}
+
+// ValidateJSONSchemaFunction defines the function implementation.
+type ValidateJSONSchemaFunction struct{}
+
+func (f *ValidateJSONSchemaFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_json_schema"
+}
+
+func (f *ValidateJSONSchemaFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check that a JSON schema is valid",
+		MarkdownDescription: "Returns true if the text is a valid JSON schema, such as the schema of a `json_schema` response format " +
+			"or of a function tool, and false otherwise. Use it in a variable `validation` block or a `precondition` " +
+			"to catch a malformed schema before it is sent to the API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "The JSON schema, e.g. from `jsonencode`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ValidateJSONSchemaFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schema string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &schema))
+	if resp.Error != nil {
+		return
+	}
+
+	_, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, err == nil))
+}
@@ -0,0 +1,29 @@
+package openai
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJSONSchemaFunction_Run(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   bool
+	}{
+		{"valid", `{"type":"object","properties":{"name":{"type":"string"}},"required":["name"],"additionalProperties":false}`, true},
+		{"empty schema", `{}`, true},
+		{"invalid json", `{"type":"object"`, false},
+		{"invalid type", `{"type":"thing"}`, false},
+		{"invalid required", `{"type":"object","required":"name"}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := runTestFunction(t, NewValidateJSONSchemaFunction(), types.BoolUnknown(), types.StringValue(tt.schema))
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, types.BoolValue(tt.want), resp.Result.Value())
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package openai
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	maxNameLength          = 256
+	maxMetadataPairs       = 16
+	maxMetadataKeyLength   = 64
+	maxMetadataValueLength = 512
+	maxAssistantTools      = 128
+)
+
+// functionNameRegex matches function and response format names: a-z, A-Z,
+// 0-9, underscores and dashes, with a maximum length of 64.
+var functionNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// metadataValidators enforce the limits the API places on metadata.
+func metadataValidators() []validator.Map {
+	return []validator.Map{
+		mapvalidator.SizeAtMost(maxMetadataPairs),
+		mapvalidator.KeysAre(stringvalidator.UTF8LengthAtMost(maxMetadataKeyLength)),
+		mapvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(maxMetadataValueLength)),
+	}
+}
+
+// functionNameValidators enforce the naming rules for functions and response formats.
+func functionNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(functionNameRegex, "must contain only a-z, A-Z, 0-9, underscores and dashes, with a maximum length of 64"),
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -61,14 +64,20 @@ func (r *VectorStoreResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"anchor": schema.StringAttribute{
-						MarkdownDescription: "Anchor timestamp after which the expiration policy applies.",
+						MarkdownDescription: "Anchor timestamp after which the expiration policy applies. Supported anchors: last_active_at.",
 						Optional:            true,
 						Computed:            true,
 						Default:             stringdefault.StaticString("last_active_at"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("last_active_at"),
+						},
 					},
 					"days": schema.Int64Attribute{
-						MarkdownDescription: "The number of days after the anchor time that the vector store will expire.",
+						MarkdownDescription: "The number of days after the anchor time that the vector store will expire, between 1 and 365.",
 						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 365),
+						},
 					},
 				},
 			},
@@ -76,6 +85,7 @@ func (r *VectorStoreResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Set of 16 key-value pairs that can be attached to a vector store. This can be useful for storing additional information about the vector store in a structured format. Keys can be a maximum of 64 characters long and values can be a maxium of 512 characters long.",
 				ElementType:         types.StringType,
 				Optional:            true,
+				Validators:          metadataValidators(),
 			},
 			"created_at": schema.Int64Attribute{
 				MarkdownDescription: "Created Time",
@@ -148,20 +158,22 @@ func (r *VectorStoreResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 	if data.ExpiresAfter != nil {
-		vsReq.ExpiresAfter.Anchor = data.ExpiresAfter.Anchor.ValueString()
-		vsReq.ExpiresAfter.Days = data.ExpiresAfter.Days.ValueInt64()
+		vsReq.ExpiresAfter = &openai.ExpiresAfter{
+			Anchor: data.ExpiresAfter.Anchor.ValueString(),
+			Days:   data.ExpiresAfter.Days.ValueInt64(),
+		}
 	}
 
 	createTimeout := 1 * time.Hour
 
-	vectorStore, err := r.client.VectorStores().CreateVectorStore(&vsReq)
+	vectorStore, err := r.client.WithContext(ctx).VectorStores().CreateVectorStore(&vsReq)
 	if err != nil {
-		resp.Diagnostics.AddError("CreateVectorStore", fmt.Sprintf("got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to create vector store", err))
 		return
 	}
 
 	err = retry.RetryContext(ctx, createTimeout, func() *retry.RetryError {
-		vectorStore, err = r.client.VectorStores().RetrieveVectorStore(vectorStore.Id)
+		vectorStore, err = r.client.WithContext(ctx).VectorStores().RetrieveVectorStore(vectorStore.Id)
 		if err != nil {
 			return retry.NonRetryableError(err)
 		}
@@ -171,7 +183,7 @@ func (r *VectorStoreResource) Create(ctx context.Context, req resource.CreateReq
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("CreateVectorStore", fmt.Sprintf("got error retrieving vector while waiting for files: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve vector store while waiting for files", err))
 		return
 	}
 	if vectorStore.FileCounts.Completed != vectorStore.FileCounts.Total {
@@ -201,9 +213,9 @@ func (r *VectorStoreResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	vectorStore, err := r.client.VectorStores().RetrieveVectorStore(data.Id.ValueString())
+	vectorStore, err := r.client.WithContext(ctx).VectorStores().RetrieveVectorStore(data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("RetrieveVectorStore", fmt.Sprintf("got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to retrieve vector store", err))
 		return
 	}
 
@@ -232,9 +244,9 @@ func (r *VectorStoreResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	deletionStatus, err := r.client.VectorStores().DeleteVectorStore(data.Id.ValueString())
+	deletionStatus, err := r.client.WithContext(ctx).VectorStores().DeleteVectorStore(data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("DeleteVectorStore", fmt.Sprintf("got error: %s", err))
+		resp.Diagnostics.Append(NewOpenAIErrorDiagnostic("Unable to delete vector store", err))
 		return
 	}
 
@@ -4,12 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccVectorStoreResource(t *testing.T) {
-	rName := acctest.RandomWithPrefix("openai_tf_test_")
+	rName := testAccRandomName("openai_tf_test_")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -62,3 +62,27 @@ resource "openai_vector_store" "test" {
 }
 `, filename, name)
 }
+
+func TestVectorStoreResource_Lifecycle(t *testing.T) {
+	fake := newFakeOpenAI(t)
+	fake.put("files", fakeObject{"id": "file_abc", "object": "file", "bytes": 120, "filename": "faq.md", "purpose": "assistants", "created_at": 1700000000})
+	l := newLifecycleTest(t, fake, "openai_vector_store")
+
+	config := map[string]interface{}{
+		"name":          "support",
+		"file_ids":      []interface{}{"file_abc"},
+		"metadata":      map[string]interface{}{"team": "support"},
+		"expires_after": map[string]interface{}{"days": 7},
+	}
+	l.apply(config)
+	id := l.attr("id")
+	assert.Equal(t, "completed", l.attr("status"))
+	assert.Equal(t, 120, fake.get("vector_stores", id)["usage_bytes"])
+	l.assertNoChanges(config)
+
+	// The files of a vector store are not returned with it, so they cannot be imported.
+	l.importState(id, "file_ids")
+
+	l.destroy()
+	assert.Nil(t, fake.get("vector_stores", id))
+}